@@ -0,0 +1,57 @@
+// Command backfill-averages recomputes the stored average attendance and
+// per-activity averages of every report from its weekly counts, using the
+// configured AVERAGE_ATTENDANCE_FORMULA. Run it from the repository root so
+// app.env is found:
+//
+//	go run ./cmd/backfill-averages
+package main
+
+import (
+	"context"
+	"log"
+	"reports/config"
+	"reports/model"
+	"reports/repository"
+)
+
+const batchSize = 500
+
+func main() {
+	loadConfig, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config: ", err)
+	}
+
+	formula, err := model.ParseAttendanceFormula(loadConfig.AverageAttendanceFormula)
+	if err != nil {
+		log.Fatal("invalid config: ", err)
+	}
+
+	db := config.ConnectionDB(&loadConfig)
+	defer db.Close()
+
+	reportRepository := repository.NewReportRepository(db)
+	ctx := context.Background()
+
+	updated := 0
+	for offset := 0; ; offset += batchSize {
+		reports, err := reportRepository.FindAll(ctx, model.ReportFilter{SortBy: "id", Limit: batchSize, Offset: offset})
+		if err != nil {
+			log.Fatal("cannot load reports: ", err)
+		}
+
+		for i := range reports {
+			reports[i].ComputeAverages(formula)
+			if err := reportRepository.UpdateAverages(ctx, &reports[i]); err != nil {
+				log.Fatalf("cannot update report %d: %v", reports[i].Id, err)
+			}
+			updated++
+		}
+
+		if len(reports) < batchSize {
+			break
+		}
+	}
+
+	log.Printf("recomputed averages for %d reports", updated)
+}
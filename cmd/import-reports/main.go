@@ -0,0 +1,100 @@
+// Command import-reports loads historical reports from a CSV or XLSX file,
+// acting as the given admin user. Every row is validated before anything is
+// saved, and -dry-run stops there. Run it from the repository root so app.env
+// is found:
+//
+//	go run ./cmd/import-reports -user admin -file reports.csv -dry-run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"reports/config"
+	"reports/data/request"
+	"reports/helper"
+	"reports/model"
+	"reports/repository"
+	"reports/service"
+	"strings"
+)
+
+func main() {
+	file := flag.String("file", "", "CSV or XLSX file to import")
+	format := flag.String("format", "", "file format, csv or xlsx (default: the file's extension)")
+	mapping := flag.String("mapping", "", "JSON file mapping column headers to report fields")
+	dryRun := flag.Bool("dry-run", false, "validate the file without saving any report")
+	batchSize := flag.Int("batch-size", 0, "reports saved per transaction (default: all in one)")
+	username := flag.String("user", "", "admin user the reports are imported as")
+	flag.Parse()
+
+	if *file == "" || *username == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	loadConfig, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatal("cannot load config: ", err)
+	}
+
+	db := config.ConnectionDB(&loadConfig)
+	defer db.Close()
+
+	reportRepository := repository.NewReportRepository(db)
+	userRepository := repository.NewUserRepository(db)
+	workerRepository := repository.NewWorkerRepository(db)
+	churchRepository := repository.NewChurchRepository(db)
+	areaRepository := repository.NewAreaRepository(db)
+
+	reportService := service.NewReportServiceImpl(reportRepository, workerRepository, churchRepository, areaRepository, &loadConfig)
+
+	user, err := userRepository.FindByUsername(context.Background(), *username)
+	if err != nil {
+		log.Fatalf("cannot load user %q: %v", *username, err)
+	}
+	if user.Role != model.RoleAdmin {
+		log.Fatalf("user %q is not an admin", *username)
+	}
+	ctx := context.WithValue(context.Background(), helper.CurrentUserKey, user)
+
+	req := request.ReportImportRequest{
+		Format:    *format,
+		DryRun:    *dryRun,
+		BatchSize: *batchSize,
+	}
+	if req.Format == "" {
+		req.Format = strings.ToLower(strings.TrimPrefix(filepath.Ext(*file), "."))
+	}
+	if *mapping != "" {
+		content, err := os.ReadFile(*mapping)
+		if err != nil {
+			log.Fatal("cannot read mapping: ", err)
+		}
+		req.Mapping = string(content)
+	}
+
+	input, err := os.Open(*file)
+	if err != nil {
+		log.Fatal("cannot open file: ", err)
+	}
+	defer input.Close()
+
+	result, importErr := reportService.Import(ctx, &req, input)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Fatal("cannot write result: ", err)
+	}
+
+	if importErr != nil {
+		log.Fatal("import failed: ", importErr)
+	}
+	if len(result.Errors) > 0 {
+		os.Exit(1)
+	}
+}
@@ -19,6 +19,18 @@ type Config struct {
 	TokenSecret    string        `mapstructure:"TOKEN_SECRET"`
 	TokenExpiresIn time.Duration `mapstructure:"TOKEN_EXPIRED_IN"`
 	TokenMaxAge    int           `mapstructure:"TOKEN_MAXAGE"`
+
+	// CookieSecure marks the token cookie Secure so browsers only send it over
+	// HTTPS. It defaults to true; set it to false for local development over HTTP.
+	CookieSecure bool `mapstructure:"COOKIE_SECURE"`
+
+	// AverageAttendanceFormula is one of worship_service (default), combined or higher_of.
+	// AverageAttendanceCheck is reject (default) or warn, for client values that disagree.
+	AverageAttendanceFormula string `mapstructure:"AVERAGE_ATTENDANCE_FORMULA"`
+	AverageAttendanceCheck   string `mapstructure:"AVERAGE_ATTENDANCE_CHECK"`
+
+	// StatsPrecision is the number of decimal places of the rounded mean in report stats.
+	StatsPrecision int `mapstructure:"STATS_PRECISION"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -26,6 +38,8 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigType("env")
 	viper.SetConfigName("app")
 
+	viper.SetDefault("COOKIE_SECURE", true)
+
 	viper.AutomaticEnv()
 
 	err = viper.ReadInConfig()
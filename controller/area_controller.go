@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/repository"
+	"reports/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AreaController struct {
+	areaService service.AreaService
+}
+
+func NewAreaController(areaService service.AreaService) *AreaController {
+	return &AreaController{areaService: areaService}
+}
+
+func (controller *AreaController) Create(ctx *gin.Context) {
+	var req request.AreaCreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	area, err := controller.areaService.Create(ctx, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidAreaParent):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parent area", "details": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create area", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Area created successfully", "area": area})
+}
+
+func (controller *AreaController) FindById(ctx *gin.Context) {
+	areaId, err := strconv.Atoi(ctx.Param("areaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area ID"})
+		return
+	}
+
+	area, err := controller.areaService.FindById(ctx, areaId)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Area not found", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"area": area})
+}
+
+func (controller *AreaController) FindAll(ctx *gin.Context) {
+	areas, err := controller.areaService.FindAll(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch areas", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"areas": areas})
+}
+
+func (controller *AreaController) Update(ctx *gin.Context) {
+	var req request.AreaUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	areaId, err := strconv.Atoi(ctx.Param("areaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area ID"})
+		return
+	}
+
+	req.Id = areaId
+
+	if err := controller.areaService.Update(ctx, &req); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAreaNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Area not found"})
+		case errors.Is(err, service.ErrInvalidAreaParent), errors.Is(err, service.ErrAreaHasChildren):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area hierarchy", "details": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update area", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Area updated successfully"})
+}
+
+func (controller *AreaController) Delete(ctx *gin.Context) {
+	areaId, err := strconv.Atoi(ctx.Param("areaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area ID"})
+		return
+	}
+
+	if err := controller.areaService.Delete(ctx, areaId); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAreaNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Area not found"})
+		case errors.Is(err, repository.ErrAreaInUse):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Area is still in use", "details": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete area", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Area deleted successfully"})
+}
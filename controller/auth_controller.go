@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthController struct {
+	authService  service.AuthService
+	tokenMaxAge  int
+	secureCookie bool
+}
+
+func NewAuthController(authService service.AuthService, tokenMaxAge int, secureCookie bool) *AuthController {
+	return &AuthController{authService: authService, tokenMaxAge: tokenMaxAge, secureCookie: secureCookie}
+}
+
+func (controller *AuthController) Login(ctx *gin.Context) {
+	var req request.LoginRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	token, err := controller.authService.Login(ctx, &req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log in", "details": err.Error()})
+		return
+	}
+
+	ctx.SetCookie("token", token.Token, controller.tokenMaxAge*60, "/", "", controller.secureCookie, true)
+	ctx.JSON(http.StatusOK, gin.H{"message": "Logged in successfully", "data": token})
+}
+
+func (controller *AuthController) Logout(ctx *gin.Context) {
+	ctx.SetCookie("token", "", -1, "/", "", controller.secureCookie, true)
+	ctx.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/repository"
+	"reports/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChurchController struct {
+	churchService service.ChurchService
+}
+
+func NewChurchController(churchService service.ChurchService) *ChurchController {
+	return &ChurchController{churchService: churchService}
+}
+
+func (controller *ChurchController) Create(ctx *gin.Context) {
+	var req request.ChurchCreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	church, err := controller.churchService.Create(ctx, &req)
+	if err != nil {
+		if errors.Is(err, repository.ErrAreaNotFound) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create church", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Church created successfully", "church": church})
+}
+
+func (controller *ChurchController) FindById(ctx *gin.Context) {
+	churchId, err := strconv.Atoi(ctx.Param("churchId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid church ID"})
+		return
+	}
+
+	church, err := controller.churchService.FindById(ctx, churchId)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Church not found", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"church": church})
+}
+
+func (controller *ChurchController) FindAll(ctx *gin.Context) {
+	var req request.ChurchListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	churches, err := controller.churchService.FindAll(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch churches", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"churches": churches})
+}
+
+func (controller *ChurchController) Update(ctx *gin.Context) {
+	var req request.ChurchUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	churchId, err := strconv.Atoi(ctx.Param("churchId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid church ID"})
+		return
+	}
+
+	req.Id = churchId
+
+	if err := controller.churchService.Update(ctx, &req); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrChurchNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Church not found"})
+		case errors.Is(err, repository.ErrAreaNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update church", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Church updated successfully"})
+}
+
+func (controller *ChurchController) Delete(ctx *gin.Context) {
+	churchId, err := strconv.Atoi(ctx.Param("churchId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid church ID"})
+		return
+	}
+
+	if err := controller.churchService.Delete(ctx, churchId); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrChurchNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Church not found"})
+		case errors.Is(err, repository.ErrChurchInUse):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Church still has reports"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete church", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Church deleted successfully"})
+}
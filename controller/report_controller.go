@@ -1,14 +1,26 @@
 package controller
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"path/filepath"
 	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
 	"reports/service"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+const mimePDF = "application/pdf"
+
 type ReportController struct {
 	reportService service.ReportService
 }
@@ -20,16 +32,36 @@ func NewReportController(reportService service.ReportService) *ReportController
 func (controller *ReportController) Create(ctx *gin.Context) {
 	var req request.ReportCreateRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		respondBindError(ctx, err)
+		return
+	}
+
+	upsert, err := strconv.ParseBool(ctx.DefaultQuery("upsert", "false"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid upsert parameter"})
+		return
+	}
+
+	if upsert {
+		created, err := controller.reportService.Upsert(ctx, &req)
+		if err != nil {
+			respondReportError(ctx, err, http.StatusInternalServerError, "Failed to save report")
+			return
+		}
+		if !created {
+			ctx.JSON(http.StatusOK, gin.H{"message": "Report replaced successfully"})
+			return
+		}
+		ctx.JSON(http.StatusCreated, gin.H{"message": "Report created successfully"})
 		return
 	}
 
 	if err := controller.reportService.Create(ctx, &req); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create report", "details": err.Error()})
+		respondReportError(ctx, err, http.StatusInternalServerError, "Failed to create report")
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "Report created successfully"})
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Report created successfully"})
 }
 
 func (controller *ReportController) FindById(ctx *gin.Context) {
@@ -39,23 +71,164 @@ func (controller *ReportController) FindById(ctx *gin.Context) {
 		return
 	}
 
+	// The body depends on the Accept header, so caches must not mix the two.
+	ctx.Header("Vary", "Accept")
+	if ctx.Query("format") == "pdf" || ctx.NegotiateFormat(binding.MIMEJSON, mimePDF) == mimePDF {
+		controller.renderPDF(ctx, reportId)
+		return
+	}
+
 	report, err := controller.reportService.FindById(ctx, reportId)
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": "Report not found", "details": err.Error()})
+		ctx.JSON(reportErrorStatus(err, http.StatusNotFound), gin.H{"error": "Report not found", "details": err.Error()})
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{"report": report})
 }
 
+// renderPDF answers GET /api/:reportId with the printable form when the
+// client accepts application/pdf or passes ?format=pdf.
+func (controller *ReportController) renderPDF(ctx *gin.Context, reportId int) {
+	var buf bytes.Buffer
+	if err := controller.reportService.RenderPDF(ctx, reportId, &buf); err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusNotFound), gin.H{"error": "Report not found", "details": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`inline; filename="report-%d.pdf"`, reportId))
+	ctx.Data(http.StatusOK, mimePDF, buf.Bytes())
+}
+
 func (controller *ReportController) FindAll(ctx *gin.Context) {
-	reports, err := controller.reportService.FindAll(ctx)
+	var req request.ReportListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	reports, pagination, err := controller.reportService.FindAll(ctx, &req)
+	if err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to fetch reports", "details": err.Error()})
+		return
+	}
+
+	ctx.Header("X-Total-Count", strconv.Itoa(pagination.Total))
+	if link := paginationLinks(ctx.Request.URL, pagination); link != "" {
+		ctx.Header("Link", link)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"reports": reports, "pagination": pagination})
+}
+
+// ExportCSV streams the reports matching the GET /api filters as CSV.
+func (controller *ReportController) ExportCSV(ctx *gin.Context) {
+	var req request.ReportExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", `attachment; filename="reports.csv"`)
+
+	if err := controller.reportService.ExportCSV(ctx, &req, ctx.Writer); err != nil {
+		respondExportError(ctx, err, "Failed to export reports")
+	}
+}
+
+// ExportXLSX sends the reports matching the GET /api filters as an Excel
+// workbook with one sheet per church or month.
+func (controller *ReportController) ExportXLSX(ctx *gin.Context) {
+	var req request.ReportExportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	ctx.Header("Content-Disposition", `attachment; filename="reports.xlsx"`)
+
+	if err := controller.reportService.ExportXLSX(ctx, &req, ctx.Writer); err != nil {
+		respondExportError(ctx, err, "Failed to export reports")
+	}
+}
+
+// Import reads reports from the CSV or XLSX file uploaded in the "file" form
+// field. The format defaults to the file's extension.
+func (controller *ReportController) Import(ctx *gin.Context) {
+	var req request.ReportImportRequest
+	if err := ctx.ShouldBind(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import options", "details": err.Error()})
+		return
+	}
+
+	header, err := ctx.FormFile("file")
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch reports", "details": err.Error()})
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Missing import file", "details": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"reports": reports})
+	if req.Format == "" {
+		req.Format = strings.ToLower(strings.TrimPrefix(filepath.Ext(header.Filename), "."))
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import file", "details": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	result, err := controller.reportService.Import(ctx, &req, file)
+	if err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to import reports", "details": err.Error(), "import": result})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"import": result})
+}
+
+// respondExportError answers a failed export. Once part of the file has been
+// sent the status can no longer change, so the error is only recorded.
+func respondExportError(ctx *gin.Context, err error, message string) {
+	if ctx.Writer.Written() {
+		_ = ctx.Error(err)
+		ctx.Abort()
+		return
+	}
+
+	ctx.Writer.Header().Del("Content-Type")
+	ctx.Writer.Header().Del("Content-Disposition")
+	ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": message, "details": err.Error()})
+}
+
+// paginationLinks builds an RFC 8288 Link header pointing at the first,
+// previous, next and last pages, keeping every other query parameter.
+func paginationLinks(current *url.URL, pagination response.Pagination) string {
+	if pagination.TotalPages == 0 {
+		return ""
+	}
+
+	pageURL := func(page int) string {
+		u := *current
+		query := u.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(pagination.Limit))
+		u.RawQuery = query.Encode()
+		return u.RequestURI()
+	}
+
+	links := []string{fmt.Sprintf(`<%s>; rel="first"`, pageURL(1))}
+	if pagination.Page > 1 {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageURL(pagination.Page-1)))
+	}
+	if pagination.Page < pagination.TotalPages {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(pagination.Page+1)))
+	}
+	links = append(links, fmt.Sprintf(`<%s>; rel="last"`, pageURL(pagination.TotalPages)))
+
+	return strings.Join(links, ", ")
 }
 
 func (controller *ReportController) Delete(ctx *gin.Context) {
@@ -66,7 +239,7 @@ func (controller *ReportController) Delete(ctx *gin.Context) {
 	}
 
 	if err := controller.reportService.Delete(ctx, reportId); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete report", "details": err.Error()})
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to delete report", "details": err.Error()})
 		return
 	}
 
@@ -76,7 +249,7 @@ func (controller *ReportController) Delete(ctx *gin.Context) {
 func (controller *ReportController) Update(ctx *gin.Context) {
 	var req request.ReportUpdateRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		respondBindError(ctx, err)
 		return
 	}
 
@@ -89,9 +262,155 @@ func (controller *ReportController) Update(ctx *gin.Context) {
 	req.Id = reportId
 
 	if err := controller.reportService.Update(ctx, &req); err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update report", "details": err.Error()})
+		respondReportError(ctx, err, http.StatusInternalServerError, "Failed to update report")
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "Report updated successfully"})
 }
+
+// Patch applies a JSON Merge Patch (RFC 7396) to the report.
+func (controller *ReportController) Patch(ctx *gin.Context) {
+	if contentType := ctx.ContentType(); contentType != "application/merge-patch+json" && contentType != "application/json" {
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/merge-patch+json"})
+		return
+	}
+
+	reportId, err := strconv.Atoi(ctx.Param("reportId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	report, err := controller.reportService.Patch(ctx, reportId, patch)
+	if err != nil {
+		respondReportError(ctx, err, http.StatusInternalServerError, "Failed to update report")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"report": report})
+}
+
+// Submit sends a draft or returned report in for review.
+func (controller *ReportController) Submit(ctx *gin.Context) {
+	controller.transition(ctx, model.ActionSubmit)
+}
+
+// Review marks a submitted report as under review.
+func (controller *ReportController) Review(ctx *gin.Context) {
+	controller.transition(ctx, model.ActionReview)
+}
+
+// Return sends a report under review back to its worker for correction.
+func (controller *ReportController) Return(ctx *gin.Context) {
+	controller.transition(ctx, model.ActionReturn)
+}
+
+// Approve approves a report under review, locking it against edits.
+func (controller *ReportController) Approve(ctx *gin.Context) {
+	controller.transition(ctx, model.ActionApprove)
+}
+
+// Reopen returns an approved report for correction.
+func (controller *ReportController) Reopen(ctx *gin.Context) {
+	controller.transition(ctx, model.ActionReopen)
+}
+
+func (controller *ReportController) transition(ctx *gin.Context, action model.ReportAction) {
+	reportId, err := strconv.Atoi(ctx.Param("reportId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	var req request.ReportTransitionRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			respondBindError(ctx, err)
+			return
+		}
+	}
+
+	report, err := controller.reportService.Transition(ctx, reportId, action, req.Note)
+	if err != nil {
+		respondReportError(ctx, err, http.StatusInternalServerError, "Failed to change report status")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"report": report})
+}
+
+// History lists the status changes of a report, oldest first.
+func (controller *ReportController) History(ctx *gin.Context) {
+	reportId, err := strconv.Atoi(ctx.Param("reportId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid report ID"})
+		return
+	}
+
+	history, err := controller.reportService.History(ctx, reportId)
+	if err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to fetch report history", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"history": history})
+}
+
+// respondReportError writes err as a JSON error. A conflict also points the
+// client at the report that already covers the period.
+func respondReportError(ctx *gin.Context, err error, fallback int, message string) {
+	if fields, ok := fieldErrors(err); ok {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Validation failed", "fields": fields})
+		return
+	}
+
+	var conflict *service.ReportConflictError
+	if errors.As(err, &conflict) {
+		location := fmt.Sprintf("/api/%d", conflict.ExistingReportId)
+		ctx.Header("Location", location)
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error":              "Report already exists",
+			"details":            err.Error(),
+			"existing_report_id": conflict.ExistingReportId,
+			"existing_report":    location,
+		})
+		return
+	}
+
+	ctx.JSON(reportErrorStatus(err, fallback), gin.H{"error": message, "details": err.Error()})
+}
+
+// reportErrorStatus maps the errors returned by the report service to an HTTP status.
+func reportErrorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, service.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, service.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, repository.ErrReportNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidTransition),
+		errors.Is(err, service.ErrReportLocked),
+		errors.Is(err, repository.ErrReportStatusChanged):
+		return http.StatusConflict
+	case errors.Is(err, repository.ErrWorkerNotFound),
+		errors.Is(err, repository.ErrChurchNotFound),
+		errors.Is(err, repository.ErrAreaNotFound),
+		errors.Is(err, service.ErrReportAreaRequired),
+		errors.Is(err, service.ErrInvalidFilter),
+		errors.Is(err, model.ErrInvalidPeriod),
+		errors.Is(err, service.ErrAverageAttendanceMismatch),
+		errors.Is(err, service.ErrInvalidPatch),
+		errors.Is(err, service.ErrInvalidImport):
+		return http.StatusBadRequest
+	default:
+		return fallback
+	}
+}
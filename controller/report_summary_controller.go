@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/repository"
+	"reports/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportSummaryController struct {
+	reportSummaryService service.ReportSummaryService
+}
+
+func NewReportSummaryController(reportSummaryService service.ReportSummaryService) *ReportSummaryController {
+	return &ReportSummaryController{reportSummaryService: reportSummaryService}
+}
+
+func (controller *ReportSummaryController) Finances(ctx *gin.Context) {
+	var req request.FinanceSummaryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	summaries, err := controller.reportSummaryService.Finances(ctx, &req)
+	if err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to summarise finances", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"finances": summaries})
+}
+
+func (controller *ReportSummaryController) Trend(ctx *gin.Context) {
+	var req request.TrendRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	trend, err := controller.reportSummaryService.Trend(ctx, &req)
+	if err != nil {
+		ctx.JSON(reportErrorStatus(err, http.StatusInternalServerError), gin.H{"error": "Failed to build trend", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"trend": trend})
+}
+
+func (controller *ReportSummaryController) Area(ctx *gin.Context) {
+	areaId, err := strconv.Atoi(ctx.Param("areaId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area ID"})
+		return
+	}
+
+	var req request.AreaSummaryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	summary, err := controller.reportSummaryService.Area(ctx, areaId, &req)
+	if err != nil {
+		status := reportErrorStatus(err, http.StatusInternalServerError)
+		if errors.Is(err, repository.ErrAreaNotFound) {
+			status = http.StatusNotFound
+		}
+		ctx.JSON(status, gin.H{"error": "Failed to summarise area", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"summary": summary})
+}
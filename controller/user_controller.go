@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/helper"
+	"reports/repository"
+	"reports/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserController struct {
+	userService service.UserService
+}
+
+func NewUserController(userService service.UserService) *UserController {
+	return &UserController{userService: userService}
+}
+
+func (controller *UserController) Create(ctx *gin.Context) {
+	var req request.UserCreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	user, err := controller.userService.Create(ctx, &req)
+	if err != nil {
+		if errors.Is(err, service.ErrUsernameTaken) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Username is already taken"})
+			return
+		}
+		if errors.Is(err, repository.ErrAreaNotFound) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "User created successfully", "user": user})
+}
+
+func (controller *UserController) FindById(ctx *gin.Context) {
+	userId, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	user, err := controller.userService.FindById(ctx, userId)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"user": user})
+}
+
+func (controller *UserController) FindAll(ctx *gin.Context) {
+	users, err := controller.userService.FindAll(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch users", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"users": users})
+}
+
+func (controller *UserController) Me(ctx *gin.Context) {
+	currentUser, _ := helper.CurrentUser(ctx)
+
+	user, err := controller.userService.FindById(ctx, currentUser.Id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"user": user})
+}
+
+func (controller *UserController) Update(ctx *gin.Context) {
+	var req request.UserUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	userId, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	req.Id = userId
+
+	if err := controller.userService.Update(ctx, &req); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		if errors.Is(err, repository.ErrAreaNotFound) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "User updated successfully"})
+}
+
+func (controller *UserController) Delete(ctx *gin.Context) {
+	userId, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := controller.userService.Delete(ctx, userId); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "User deleted successfully"})
+}
+
+func (controller *UserController) ChangePassword(ctx *gin.Context) {
+	var req request.PasswordChangeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	currentUser, _ := helper.CurrentUser(ctx)
+
+	if err := controller.userService.ChangePassword(ctx, currentUser.Id, &req); err != nil {
+		if errors.Is(err, service.ErrWrongCurrentPassword) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Current password is incorrect"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change password", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password changed successfully"})
+}
+
+func (controller *UserController) ResetPassword(ctx *gin.Context) {
+	var req request.PasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	userId, err := strconv.Atoi(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := controller.userService.ResetPassword(ctx, userId, &req); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reset password", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
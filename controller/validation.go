@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reports/data/response"
+	"reports/model"
+	"reports/service"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// respondBindError answers a failed ShouldBindJSON. Validation failures get a
+// 422 listing every failing field; malformed JSON gets a 400.
+func respondBindError(ctx *gin.Context, err error) {
+	if fields, ok := fieldErrors(err); ok {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Validation failed", "fields": fields})
+		return
+	}
+
+	ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+}
+
+// fieldErrors converts validator and JSON type errors, and the service's own
+// validation failures, into FieldErrors.
+func fieldErrors(err error) ([]response.FieldError, bool) {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		fields := make([]response.FieldError, 0, len(validationErrors))
+		for _, fieldErr := range validationErrors {
+			fields = append(fields, response.FieldError{
+				Field:  fieldPath(fieldErr.Namespace()),
+				Reason: fieldErr.Tag(),
+				Param:  fieldErr.Param(),
+			})
+		}
+		return fields, true
+	}
+
+	var serviceErr *service.ValidationError
+	if errors.As(err, &serviceErr) {
+		return []response.FieldError{{Field: serviceErr.Field, Reason: serviceErr.Reason, Param: serviceErr.Param}}, true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return []response.FieldError{{Field: typeErr.Field, Reason: "type", Param: typeErr.Type.String()}}, true
+	}
+
+	switch {
+	case errors.Is(err, model.ErrInvalidPeriod):
+		return []response.FieldError{{Field: "month_of", Reason: "datetime", Param: "2006-01"}}, true
+	case errors.Is(err, service.ErrAverageAttendanceMismatch):
+		return []response.FieldError{{Field: "average_attendance", Reason: "mismatch"}}, true
+	}
+
+	return nil, false
+}
+
+// fieldPath drops the struct name from a validator namespace such as
+// "ReportCreateRequest.worship_service[2]".
+func fieldPath(namespace string) string {
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"reports/data/request"
+	"reports/repository"
+	"reports/service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerController struct {
+	workerService service.WorkerService
+}
+
+func NewWorkerController(workerService service.WorkerService) *WorkerController {
+	return &WorkerController{workerService: workerService}
+}
+
+func (controller *WorkerController) Create(ctx *gin.Context) {
+	var req request.WorkerCreateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	worker, err := controller.workerService.Create(ctx, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Linked user does not exist"})
+		case errors.Is(err, repository.ErrAreaNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create worker", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Worker created successfully", "worker": worker})
+}
+
+func (controller *WorkerController) FindById(ctx *gin.Context) {
+	workerId, err := strconv.Atoi(ctx.Param("workerId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid worker ID"})
+		return
+	}
+
+	worker, err := controller.workerService.FindById(ctx, workerId)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Worker not found", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"worker": worker})
+}
+
+func (controller *WorkerController) FindAll(ctx *gin.Context) {
+	var req request.WorkerListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "details": err.Error()})
+		return
+	}
+
+	workers, err := controller.workerService.FindAll(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch workers", "details": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"workers": workers})
+}
+
+func (controller *WorkerController) Update(ctx *gin.Context) {
+	var req request.WorkerUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	workerId, err := strconv.Atoi(ctx.Param("workerId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid worker ID"})
+		return
+	}
+
+	req.Id = workerId
+
+	if err := controller.workerService.Update(ctx, &req); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrWorkerNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Worker not found"})
+		case errors.Is(err, repository.ErrUserNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Linked user does not exist"})
+		case errors.Is(err, repository.ErrAreaNotFound):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Area does not exist"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update worker", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Worker updated successfully"})
+}
+
+func (controller *WorkerController) Delete(ctx *gin.Context) {
+	workerId, err := strconv.Atoi(ctx.Param("workerId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid worker ID"})
+		return
+	}
+
+	if err := controller.workerService.Delete(ctx, workerId); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrWorkerNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Worker not found"})
+		case errors.Is(err, repository.ErrWorkerInUse):
+			ctx.JSON(http.StatusConflict, gin.H{"error": "Worker still has reports; mark them inactive instead"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete worker", "details": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Worker deleted successfully"})
+}
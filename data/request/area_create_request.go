@@ -0,0 +1,7 @@
+package request
+
+type AreaCreateRequest struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	Level    string `json:"level" binding:"required,oneof=region district area"`
+	ParentId int    `json:"parent_id" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// AreaSummaryRequest holds the query parameters accepted by GET /api/summaries/areas/:areaId.
+type AreaSummaryRequest struct {
+	Month string `form:"month" binding:"required,datetime=2006-01"`
+}
@@ -0,0 +1,12 @@
+package request
+
+type ChurchCreateRequest struct {
+	Name          string `json:"name" binding:"required,max=100"`
+	Address       string `json:"address" binding:"max=255"`
+	Barangay      string `json:"barangay" binding:"max=100"`
+	Municipality  string `json:"municipality" binding:"max=100"`
+	Province      string `json:"province" binding:"max=100"`
+	DateOrganized string `json:"date_organized" binding:"omitempty,datetime=2006-01-02"`
+	Status        string `json:"status" binding:"omitempty,oneof=preaching_point organized_church"`
+	AreaId        int    `json:"area_id" binding:"omitempty,min=1"`
+}
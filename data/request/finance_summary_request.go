@@ -0,0 +1,11 @@
+package request
+
+// FinanceSummaryRequest holds the query parameters accepted by GET /api/summaries/finances.
+type FinanceSummaryRequest struct {
+	GroupBy   string `form:"group_by" binding:"omitempty,oneof=church area month"`
+	AreaId    int    `form:"area_id" binding:"omitempty,min=1"`
+	WorkerId  int    `form:"worker_id" binding:"omitempty,min=1"`
+	ChurchId  int    `form:"church_id" binding:"omitempty,min=1"`
+	MonthFrom string `form:"month_from" binding:"omitempty,datetime=2006-01"`
+	MonthTo   string `form:"month_to" binding:"omitempty,datetime=2006-01"`
+}
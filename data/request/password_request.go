@@ -0,0 +1,10 @@
+package request
+
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=8,max=72"`
+}
+
+type PasswordResetRequest struct {
+	NewPassword string `json:"new_password" binding:"required,min=8,max=72"`
+}
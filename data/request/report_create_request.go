@@ -1,34 +1,41 @@
 package request
 
+import "reports/model"
+
+// ReportCreateRequest is the body of POST /api, validated by gin's binding tags.
+// Weekly lists hold at most six entries, one per calendar week a month can touch,
+// and counts may not be negative. Entries may be dated objects or, as before, bare
+// counts placed on the month's Sundays; a null count marks a week in which the
+// activity was not held. At most 100 names of up to 100 characters are accepted.
 type ReportCreateRequest struct {
-	MonthOf                         string   `json:"month_of" validate:"required"`
-	WorkerName                      string   `json:"worker_name" validate:"required"`
-	AreaOfAssignment                string   `json:"area_of_assignment" validate:"required"`
-	NameOfChurch                    string   `json:"name_of_church" validate:"required"`
-	WorshipService                  []int    `json:"worship_service" validate:"required"`
-	SundaySchool                    []int    `json:"sunday_school" validate:"required"`
-	PrayerMeetings                  []int    `json:"prayer_meetings,omitempty"`
-	BibleStudies                    []int    `json:"bible_studies,omitempty"`
-	MensFellowships                 []int    `json:"mens_fellowships,omitempty"`
-	WomensFellowships               []int    `json:"womens_fellowships,omitempty"`
-	YouthFellowships                []int    `json:"youth_fellowships,omitempty"`
-	ChildFellowships                []int    `json:"child_fellowships,omitempty"`
-	Outreach                        []int    `json:"outreach,omitempty"`
-	TrainingOrSeminars              []int    `json:"training_or_seminars,omitempty"`
-	LeadershipConferences           []int    `json:"leadership_conferences,omitempty"`
-	LeadershipTraining              []int    `json:"leadership_training,omitempty"`
-	Others                          []int    `json:"others,omitempty"`
-	FamilyDays                      []int    `json:"family_days,omitempty"`
-	TithesAndOfferings              []int    `json:"tithes_and_offerings,omitempty"`
-	HomeVisited                     []int    `json:"home_visited,omitempty"`
-	BibleStudyOrGroupLed            []int    `json:"bible_study_or_group_led,omitempty"`
-	SermonOrMessagePreached         []int    `json:"sermon_or_message_preached,omitempty"`
-	PersonNewlyContacted            []int    `json:"person_newly_contacted,omitempty"`
-	PersonFollowedUp                []int    `json:"person_followed_up,omitempty"`
-	PersonLedToChrist               []int    `json:"person_led_to_christ,omitempty"`
-	Names                           []string `json:"names,omitempty"`
-	NarrativeReport                 string   `json:"narrative_report" validate:"required"`
-	ChallengesAndProblemEncountered string   `json:"challenges_and_problem_encountered" validate:"required"`
-	PrayerRequest                   string   `json:"prayer_request" validate:"required"`
-	AverageAttendance               float64  `json:"average_attendance"`
+	MonthOf                         string               `json:"month_of" binding:"required,datetime=2006-01"`
+	WorkerId                        int                  `json:"worker_id" binding:"required,min=1"`
+	AreaId                          int                  `json:"area_id,omitempty" binding:"omitempty,min=1"`
+	ChurchId                        int                  `json:"church_id" binding:"required,min=1"`
+	WorshipService                  model.WeeklyEntries  `json:"worship_service" binding:"required,min=1,max=6,dive"`
+	SundaySchool                    model.WeeklyEntries  `json:"sunday_school" binding:"required,min=1,max=6,dive"`
+	PrayerMeetings                  model.WeeklyEntries  `json:"prayer_meetings,omitempty" binding:"omitempty,max=6,dive"`
+	BibleStudies                    model.WeeklyEntries  `json:"bible_studies,omitempty" binding:"omitempty,max=6,dive"`
+	MensFellowships                 model.WeeklyEntries  `json:"mens_fellowships,omitempty" binding:"omitempty,max=6,dive"`
+	WomensFellowships               model.WeeklyEntries  `json:"womens_fellowships,omitempty" binding:"omitempty,max=6,dive"`
+	YouthFellowships                model.WeeklyEntries  `json:"youth_fellowships,omitempty" binding:"omitempty,max=6,dive"`
+	ChildFellowships                model.WeeklyEntries  `json:"child_fellowships,omitempty" binding:"omitempty,max=6,dive"`
+	Outreach                        model.WeeklyEntries  `json:"outreach,omitempty" binding:"omitempty,max=6,dive"`
+	TrainingOrSeminars              model.WeeklyEntries  `json:"training_or_seminars,omitempty" binding:"omitempty,max=6,dive"`
+	LeadershipConferences           model.WeeklyEntries  `json:"leadership_conferences,omitempty" binding:"omitempty,max=6,dive"`
+	LeadershipTraining              model.WeeklyEntries  `json:"leadership_training,omitempty" binding:"omitempty,max=6,dive"`
+	Others                          model.WeeklyEntries  `json:"others,omitempty" binding:"omitempty,max=6,dive"`
+	FamilyDays                      model.WeeklyEntries  `json:"family_days,omitempty" binding:"omitempty,max=6,dive"`
+	HomeVisited                     model.WeeklyEntries  `json:"home_visited,omitempty" binding:"omitempty,max=6,dive"`
+	BibleStudyOrGroupLed            model.WeeklyEntries  `json:"bible_study_or_group_led,omitempty" binding:"omitempty,max=6,dive"`
+	SermonOrMessagePreached         model.WeeklyEntries  `json:"sermon_or_message_preached,omitempty" binding:"omitempty,max=6,dive"`
+	PersonNewlyContacted            model.WeeklyEntries  `json:"person_newly_contacted,omitempty" binding:"omitempty,max=6,dive"`
+	PersonFollowedUp                model.WeeklyEntries  `json:"person_followed_up,omitempty" binding:"omitempty,max=6,dive"`
+	PersonLedToChrist               model.WeeklyEntries  `json:"person_led_to_christ,omitempty" binding:"omitempty,max=6,dive"`
+	Names                           []string             `json:"names,omitempty" binding:"omitempty,max=100,dive,required,max=100"`
+	NarrativeReport                 string               `json:"narrative_report" binding:"required,max=10000"`
+	ChallengesAndProblemEncountered string               `json:"challenges_and_problem_encountered" binding:"required,max=10000"`
+	PrayerRequest                   string               `json:"prayer_request" binding:"required,max=10000"`
+	AverageAttendance               *float64             `json:"average_attendance,omitempty" binding:"omitempty,min=0"`
+	Finances                        model.ReportFinances `json:"finances"`
 }
@@ -0,0 +1,10 @@
+package request
+
+// ReportExportRequest holds the query parameters accepted by the report
+// exports: the filters and sort of GET /api, without paging, and the
+// comma-separated columns to include. SheetBy only applies to XLSX.
+type ReportExportRequest struct {
+	ReportListRequest
+	Columns string `form:"columns"`
+	SheetBy string `form:"sheet_by" binding:"omitempty,oneof=church month"`
+}
@@ -0,0 +1,13 @@
+package request
+
+// ReportImportRequest holds the options of a report import. Format is csv or
+// xlsx. Mapping is a JSON object from column headers to report fields; a
+// column whose header already names a field needs no entry, and mapping a
+// header to "" ignores it. BatchSize 0 saves every valid row in one
+// transaction.
+type ReportImportRequest struct {
+	Format    string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	Mapping   string `form:"mapping"`
+	DryRun    bool   `form:"dry_run"`
+	BatchSize int    `form:"batch_size" binding:"omitempty,min=1"`
+}
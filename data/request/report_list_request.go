@@ -0,0 +1,20 @@
+package request
+
+// ReportListRequest holds the query parameters accepted by GET /api.
+// Months use the YYYY-MM form; timestamps accept YYYY-MM-DD or RFC 3339.
+type ReportListRequest struct {
+	AreaId      int    `form:"area_id" binding:"omitempty,min=1"`
+	WorkerId    int    `form:"worker_id" binding:"omitempty,min=1"`
+	ChurchId    int    `form:"church_id" binding:"omitempty,min=1"`
+	Status      string `form:"status" binding:"omitempty,oneof=draft submitted under_review returned approved"`
+	MonthFrom   string `form:"month_from" binding:"omitempty,datetime=2006-01"`
+	MonthTo     string `form:"month_to" binding:"omitempty,datetime=2006-01"`
+	CreatedFrom string `form:"created_from"`
+	CreatedTo   string `form:"created_to"`
+	UpdatedFrom string `form:"updated_from"`
+	UpdatedTo   string `form:"updated_to"`
+	Sort        string `form:"sort"`
+	Order       string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Page        int    `form:"page" binding:"omitempty,min=1"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
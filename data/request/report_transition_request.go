@@ -0,0 +1,8 @@
+package request
+
+// ReportTransitionRequest is the optional body of the report workflow
+// endpoints, such as POST /api/:reportId/submit. Returning and reopening a
+// report require a note saying what needs correcting.
+type ReportTransitionRequest struct {
+	Note string `json:"note" binding:"max=2000"`
+}
@@ -0,0 +1,12 @@
+package request
+
+// TrendRequest holds the query parameters accepted by GET /api/summaries/trends.
+// The range defaults to the twelve months ending with the current one.
+type TrendRequest struct {
+	Metric    string `form:"metric" binding:"required"`
+	AreaId    int    `form:"area_id" binding:"omitempty,min=1"`
+	WorkerId  int    `form:"worker_id" binding:"omitempty,min=1"`
+	ChurchId  int    `form:"church_id" binding:"omitempty,min=1"`
+	MonthFrom string `form:"month_from" binding:"omitempty,datetime=2006-01"`
+	MonthTo   string `form:"month_to" binding:"omitempty,datetime=2006-01"`
+}
@@ -0,0 +1,10 @@
+package request
+
+type UserCreateRequest struct {
+	Username string `json:"username" binding:"required,max=100"`
+	Name     string `json:"name" binding:"required,max=100"`
+	Email    string `json:"email" binding:"omitempty,email,max=255"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+	Role     string `json:"role" binding:"required,oneof=worker area_supervisor admin auditor"`
+	AreaId   int    `json:"area_id" binding:"required_if=Role area_supervisor,omitempty,min=1"`
+}
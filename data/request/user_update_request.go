@@ -0,0 +1,9 @@
+package request
+
+type UserUpdateRequest struct {
+	Id     int    `json:"id"`
+	Name   string `json:"name" binding:"required,max=100"`
+	Email  string `json:"email" binding:"omitempty,email,max=255"`
+	Role   string `json:"role" binding:"required,oneof=worker area_supervisor admin auditor"`
+	AreaId int    `json:"area_id" binding:"required_if=Role area_supervisor,omitempty,min=1"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type WorkerListRequest struct {
+	AreaId int `form:"area_id" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,11 @@
+package request
+
+type WorkerUpdateRequest struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name" binding:"required,max=100"`
+	ContactNumber string `json:"contact_number" binding:"max=50"`
+	Email         string `json:"email" binding:"omitempty,email,max=255"`
+	Status        string `json:"status" binding:"required,oneof=active inactive"`
+	UserId        int    `json:"user_id" binding:"omitempty,min=1"`
+	AreaId        int    `json:"area_id" binding:"omitempty,min=1"`
+}
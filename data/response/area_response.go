@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+type AreaResponse struct {
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	Level     string    `json:"level"`
+	ParentId  int       `json:"parent_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,24 @@
+package response
+
+import "reports/model"
+
+type ChurchSummaryResponse struct {
+	ChurchId          int                              `json:"church_id"`
+	ChurchName        string                           `json:"church_name"`
+	Expected          bool                             `json:"expected"`
+	Reports           int                              `json:"reports"`
+	AverageAttendance float64                          `json:"average_attendance"`
+	Activities        map[string]model.ActivitySummary `json:"activities"`
+}
+
+type AreaSummaryResponse struct {
+	AreaId            int                              `json:"area_id"`
+	AreaName          string                           `json:"area_name"`
+	MonthOf           model.Period                     `json:"month_of"`
+	ReportsReceived   int                              `json:"reports_received"`
+	ReportsExpected   int                              `json:"reports_expected"`
+	ChurchesMissing   int                              `json:"churches_missing"`
+	AverageAttendance float64                          `json:"average_attendance"`
+	Activities        map[string]model.ActivitySummary `json:"activities"`
+	Churches          []ChurchSummaryResponse          `json:"churches"`
+}
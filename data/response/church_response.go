@@ -0,0 +1,17 @@
+package response
+
+import "time"
+
+type ChurchResponse struct {
+	Id            int       `json:"id"`
+	Name          string    `json:"name"`
+	Address       string    `json:"address,omitempty"`
+	Barangay      string    `json:"barangay,omitempty"`
+	Municipality  string    `json:"municipality,omitempty"`
+	Province      string    `json:"province,omitempty"`
+	DateOrganized string    `json:"date_organized,omitempty"`
+	Status        string    `json:"status"`
+	AreaId        int       `json:"area_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
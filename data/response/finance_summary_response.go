@@ -0,0 +1,17 @@
+package response
+
+import "reports/model"
+
+type FinanceSummaryResponse struct {
+	GroupId       int          `json:"group_id,omitempty"`
+	GroupName     string       `json:"group_name,omitempty"`
+	MonthOf       model.Period `json:"month_of"`
+	Reports       int          `json:"reports"`
+	Tithes        model.Money  `json:"tithes"`
+	Offerings     model.Money  `json:"offerings"`
+	SpecialGifts  model.Money  `json:"special_gifts"`
+	Total         model.Money  `json:"total"`
+	Change        *model.Money `json:"change"`
+	ChangePercent *float64     `json:"change_percent"`
+	YearToDate    model.Money  `json:"year_to_date"`
+}
@@ -0,0 +1,16 @@
+package response
+
+// ImportRowError lists why one row of an imported file was not saved. Row is
+// the row number in the file, counting the header as row 1.
+type ImportRowError struct {
+	Row    int          `json:"row"`
+	Fields []FieldError `json:"fields"`
+}
+
+type ReportImportResponse struct {
+	DryRun   bool             `json:"dry_run"`
+	Rows     int              `json:"rows"`
+	Valid    int              `json:"valid"`
+	Imported int              `json:"imported"`
+	Errors   []ImportRowError `json:"errors"`
+}
@@ -0,0 +1,17 @@
+package response
+
+import (
+	"reports/model"
+	"time"
+)
+
+type ReportStatusChangeResponse struct {
+	Id        int                `json:"id"`
+	Action    model.ReportAction `json:"action"`
+	From      model.ReportStatus `json:"from"`
+	To        model.ReportStatus `json:"to"`
+	ActorId   int                `json:"actor_id,omitempty"`
+	ActorName string             `json:"actor_name,omitempty"`
+	Note      string             `json:"note,omitempty"`
+	ChangedAt time.Time          `json:"changed_at"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import "reports/model"
+
+type TrendPointResponse struct {
+	MonthOf        model.Period      `json:"month_of"`
+	Reports        int               `json:"reports"`
+	Value          *float64          `json:"value"`
+	MonthOverMonth *model.TrendDelta `json:"month_over_month"`
+	YearOverYear   *model.TrendDelta `json:"year_over_year"`
+}
+
+type TrendResponse struct {
+	Metric    string               `json:"metric"`
+	MonthFrom model.Period         `json:"month_from"`
+	MonthTo   model.Period         `json:"month_to"`
+	Points    []TrendPointResponse `json:"points"`
+}
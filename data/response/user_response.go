@@ -0,0 +1,14 @@
+package response
+
+import "time"
+
+type UserResponse struct {
+	Id        int       `json:"id"`
+	Username  string    `json:"username"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email,omitempty"`
+	Role      string    `json:"role"`
+	AreaId    int       `json:"area_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,9 @@
+package response
+
+// FieldError describes one field that failed validation. Reason is the
+// failing rule (required, min, max, datetime, type, ...) and Param its argument.
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	Param  string `json:"param,omitempty"`
+}
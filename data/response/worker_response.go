@@ -0,0 +1,15 @@
+package response
+
+import "time"
+
+type WorkerResponse struct {
+	Id            int       `json:"id"`
+	Name          string    `json:"name"`
+	ContactNumber string    `json:"contact_number,omitempty"`
+	Email         string    `json:"email,omitempty"`
+	Status        string    `json:"status"`
+	UserId        int       `json:"user_id,omitempty"`
+	AreaId        int       `json:"area_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package helper
+
+import (
+	"context"
+	"reports/model"
+)
+
+// CurrentUserKey is the gin context key under which the authenticated user is stored.
+const CurrentUserKey = "currentUser"
+
+// CurrentUser returns the authenticated user attached to the request context, if any.
+func CurrentUser(ctx context.Context) (*model.User, bool) {
+	user, ok := ctx.Value(CurrentUserKey).(*model.User)
+	return user, ok
+}
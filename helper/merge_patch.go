@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidMergePatch = errors.New("merge patch must be a JSON object")
+
+// MergePatch applies an RFC 7396 JSON Merge Patch to the original document.
+// Members set to null in the patch are removed, objects are merged recursively
+// and every other value replaces the original one.
+func MergePatch(original []byte, patch []byte) ([]byte, error) {
+	var target interface{}
+	if err := json.Unmarshal(original, &target); err != nil {
+		return nil, err
+	}
+
+	var patchValue interface{}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, ErrInvalidMergePatch
+	}
+	if _, ok := patchValue.(map[string]interface{}); !ok {
+		return nil, ErrInvalidMergePatch
+	}
+
+	return json.Marshal(mergeValue(target, patchValue))
+}
+
+func mergeValue(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergeValue(targetObject[key], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Cases from RFC 7396, appendix A, limited to object patches.
+	tests := []struct {
+		original string
+		patch    string
+		want     string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{`{"a":"b"}`, `{}`, `{"a":"b"}`},
+	}
+
+	for _, tt := range tests {
+		got, err := MergePatch([]byte(tt.original), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("MergePatch(%s, %s) returned error %v", tt.original, tt.patch, err)
+			continue
+		}
+
+		var gotValue, wantValue interface{}
+		if err := json.Unmarshal(got, &gotValue); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(tt.want), &wantValue); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotValue, wantValue) {
+			t.Errorf("MergePatch(%s, %s) = %s, want %s", tt.original, tt.patch, got, tt.want)
+		}
+	}
+}
+
+func TestMergePatchRejectsNonObjects(t *testing.T) {
+	for _, patch := range []string{`["a"]`, `"a"`, `null`, `1`, `{"a":`, ``} {
+		if _, err := MergePatch([]byte(`{"a":"b"}`), []byte(patch)); !errors.Is(err, ErrInvalidMergePatch) {
+			t.Errorf("MergePatch with patch %q returned %v, want ErrInvalidMergePatch", patch, err)
+		}
+	}
+}
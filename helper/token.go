@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// GenerateToken signs an HS256 JWT whose subject is the given user id.
+func GenerateToken(ttl time.Duration, userId int, secretJWTKey string) (string, error) {
+	now := time.Now().UTC()
+
+	claims := jwt.RegisteredClaims{
+		Subject:   strconv.Itoa(userId),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secretJWTKey))
+	if err != nil {
+		return "", fmt.Errorf("generating JWT Token failed: %w", err)
+	}
+
+	return token, nil
+}
+
+// ValidateToken verifies the signature and expiry of a JWT and returns the user id in its subject.
+func ValidateToken(token string, signedJWTKey string) (int, error) {
+	claims := &jwt.RegisteredClaims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected method: %s", t.Header["alg"])
+		}
+		return []byte(signedJWTKey), nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("invalid token: %w", err)
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, errors.New("invalid token claim")
+	}
+
+	return userId, nil
+}
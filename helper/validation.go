@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterJSONFieldNames makes the validator report fields by their JSON name,
+// so a client sees "worship_service[2]" rather than "WorshipService[2]".
+func RegisterJSONFieldNames(validate *validator.Validate) {
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		switch name {
+		case "-":
+			return ""
+		case "":
+			return field.Name
+		default:
+			return name
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reports/config"
 	"reports/controller"
+	"reports/middleware"
 	"reports/repository"
 	"reports/router"
 	"reports/service"
@@ -24,14 +25,34 @@ func main() {
 
 	// Repository
 	reportRepository := repository.NewReportRepository(db)
+	userRepository := repository.NewUserRepository(db)
+	workerRepository := repository.NewWorkerRepository(db)
+	churchRepository := repository.NewChurchRepository(db)
+	areaRepository := repository.NewAreaRepository(db)
+	reportSummaryRepository := repository.NewReportSummaryRepository(db)
 
 	// Service
-	reportService := service.NewReportServiceImpl(reportRepository)
+	reportService := service.NewReportServiceImpl(reportRepository, workerRepository, churchRepository, areaRepository, &loadConfig)
+	authService := service.NewAuthServiceImpl(userRepository, &loadConfig)
+	userService := service.NewUserServiceImpl(userRepository, areaRepository)
+	workerService := service.NewWorkerServiceImpl(workerRepository, userRepository, areaRepository)
+	churchService := service.NewChurchServiceImpl(churchRepository, areaRepository)
+	areaService := service.NewAreaServiceImpl(areaRepository)
+	reportSummaryService := service.NewReportSummaryServiceImpl(reportSummaryRepository, areaRepository)
 
 	// Controller
 	reportController := controller.NewReportController(reportService)
+	authController := controller.NewAuthController(authService, loadConfig.TokenMaxAge, loadConfig.CookieSecure)
+	userController := controller.NewUserController(userService)
+	workerController := controller.NewWorkerController(workerService)
+	churchController := controller.NewChurchController(churchService)
+	areaController := controller.NewAreaController(areaService)
+	reportSummaryController := controller.NewReportSummaryController(reportSummaryService)
 
-	router := router.NewRouter(reportController)
+	// Middleware
+	authMiddleware := middleware.DeserializeUser(userRepository, &loadConfig)
+
+	router := router.NewRouter(authMiddleware, authController, userController, workerController, churchController, areaController, reportController, reportSummaryController)
 
 	server := &http.Server{
 		Addr:    ":8080",
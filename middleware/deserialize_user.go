@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"reports/config"
+	"reports/helper"
+	"reports/repository"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeserializeUser rejects requests without a valid JWT and stores the
+// authenticated user in the gin context under helper.CurrentUserKey.
+// The token is read from the Authorization header or the "token" cookie.
+func DeserializeUser(userRepository repository.UserRepository, config *config.Config) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var token string
+
+		authorizationHeader := ctx.Request.Header.Get("Authorization")
+		fields := strings.Fields(authorizationHeader)
+
+		if len(fields) == 2 && strings.EqualFold(fields[0], "Bearer") {
+			token = fields[1]
+		} else if cookie, err := ctx.Cookie("token"); err == nil {
+			token = cookie
+		}
+
+		if token == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "You are not logged in"})
+			return
+		}
+
+		userId, err := helper.ValidateToken(token, config.TokenSecret)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token", "details": err.Error()})
+			return
+		}
+
+		user, err := userRepository.FindById(ctx, userId)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "The user belonging to this token no longer exists"})
+			return
+		}
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to load the current user", "details": err.Error()})
+			return
+		}
+
+		ctx.Set(helper.CurrentUserKey, user)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"reports/helper"
+	"reports/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRole aborts with 403 unless the authenticated user has one of the given roles.
+// It must run after DeserializeUser.
+func RequireRole(roles ...model.Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, ok := helper.CurrentUser(ctx)
+		if !ok {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "You are not logged in"})
+			return
+		}
+
+		for _, role := range roles {
+			if user.Role == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "You do not have permission to perform this action"})
+	}
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type AreaLevel string
+
+const (
+	AreaLevelRegion   AreaLevel = "region"
+	AreaLevelDistrict AreaLevel = "district"
+	AreaLevelArea     AreaLevel = "area"
+)
+
+// ParentLevel returns the level an area's parent must have. Regions have no parent.
+func (l AreaLevel) ParentLevel() AreaLevel {
+	switch l {
+	case AreaLevelDistrict:
+		return AreaLevelRegion
+	case AreaLevelArea:
+		return AreaLevelDistrict
+	default:
+		return ""
+	}
+}
+
+type Area struct {
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	Level     AreaLevel `json:"level"`
+	ParentId  int       `json:"parent_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,92 @@
+package model
+
+// ActivitySummary totals one weekly activity over several reports.
+type ActivitySummary struct {
+	Total    int `json:"total"`
+	Sessions int `json:"sessions"`
+	// Average is the sum of the reports' averages, the combined weekly
+	// figure of the churches that reported.
+	Average float64 `json:"average"`
+}
+
+func (s ActivitySummary) Add(other ActivitySummary) ActivitySummary {
+	return ActivitySummary{
+		Total:    s.Total + other.Total,
+		Sessions: s.Sessions + other.Sessions,
+		Average:  s.Average + other.Average,
+	}
+}
+
+// ChurchSummary is one church's share of an area's month. Expected is true
+// for churches of the area that existed by the end of the month; a church
+// outside the area appears only when one of the area's workers reported on it.
+type ChurchSummary struct {
+	ChurchId          int
+	ChurchName        string
+	Expected          bool
+	Reports           int
+	AverageAttendance float64
+	Activities        map[string]ActivitySummary
+}
+
+// AreaSummary consolidates every report filed in an area and its sub-areas
+// for one month.
+type AreaSummary struct {
+	Area     Area
+	MonthOf  Period
+	Churches []ChurchSummary
+}
+
+// ReportsReceived counts the reports filed for the month.
+func (s AreaSummary) ReportsReceived() int {
+	received := 0
+	for _, church := range s.Churches {
+		received += church.Reports
+	}
+	return received
+}
+
+// ReportsExpected counts the churches expected to report, one report each.
+func (s AreaSummary) ReportsExpected() int {
+	expected := 0
+	for _, church := range s.Churches {
+		if church.Expected {
+			expected++
+		}
+	}
+	return expected
+}
+
+// ChurchesMissing counts the expected churches without a report.
+func (s AreaSummary) ChurchesMissing() int {
+	missing := 0
+	for _, church := range s.Churches {
+		if church.Expected && church.Reports == 0 {
+			missing++
+		}
+	}
+	return missing
+}
+
+// AverageAttendance sums the churches' average attendance.
+func (s AreaSummary) AverageAttendance() float64 {
+	total := 0.0
+	for _, church := range s.Churches {
+		total += church.AverageAttendance
+	}
+	return total
+}
+
+// Activities sums every activity over the churches.
+func (s AreaSummary) Activities() map[string]ActivitySummary {
+	activities := make(map[string]ActivitySummary, len(ActivityKeys()))
+	for _, key := range ActivityKeys() {
+		activities[key] = ActivitySummary{}
+	}
+	for _, church := range s.Churches {
+		for key, activity := range church.Activities {
+			activities[key] = activities[key].Add(activity)
+		}
+	}
+	return activities
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// AttendanceFormula selects how a report's overall AverageAttendance is derived
+// from its weekly WorshipService and SundaySchool counts. Weeks in which neither
+// was held are left out; a week in which only one was held counts the other as 0.
+type AttendanceFormula string
+
+const (
+	// AttendanceWorshipService averages the weekly worship service counts only.
+	AttendanceWorshipService AttendanceFormula = "worship_service"
+	// AttendanceCombined averages worship service plus Sunday school per week,
+	// for churches where the two gatherings have different attendees.
+	AttendanceCombined AttendanceFormula = "combined"
+	// AttendanceHigherOf averages the larger of the two counts per week,
+	// for churches where most people attend both.
+	AttendanceHigherOf AttendanceFormula = "higher_of"
+
+	DefaultAttendanceFormula = AttendanceWorshipService
+)
+
+// ParseAttendanceFormula validates a configured formula name. An empty value
+// selects DefaultAttendanceFormula.
+func ParseAttendanceFormula(value string) (AttendanceFormula, error) {
+	switch formula := AttendanceFormula(value); formula {
+	case "":
+		return DefaultAttendanceFormula, nil
+	case AttendanceWorshipService, AttendanceCombined, AttendanceHigherOf:
+		return formula, nil
+	default:
+		return "", fmt.Errorf("unknown attendance formula %q", value)
+	}
+}
+
+// AttendanceCheck selects what happens when a client sends an
+// average_attendance that disagrees with the one computed on the server.
+type AttendanceCheck string
+
+const (
+	// AttendanceCheckReject refuses the report.
+	AttendanceCheckReject AttendanceCheck = "reject"
+	// AttendanceCheckWarn logs the difference and keeps the computed value.
+	AttendanceCheckWarn AttendanceCheck = "warn"
+
+	DefaultAttendanceCheck = AttendanceCheckReject
+)
+
+// ParseAttendanceCheck validates a configured check. An empty value selects
+// DefaultAttendanceCheck.
+func ParseAttendanceCheck(value string) (AttendanceCheck, error) {
+	switch check := AttendanceCheck(value); check {
+	case "":
+		return DefaultAttendanceCheck, nil
+	case AttendanceCheckReject, AttendanceCheckWarn:
+		return check, nil
+	default:
+		return "", fmt.Errorf("unknown attendance check %q", value)
+	}
+}
+
+// Compute returns the average attendance rounded to a whole person, the same
+// rounding CalculateAverage uses for the per-activity averages. Worship service
+// and Sunday school entries on the same date count as the same week.
+func (f AttendanceFormula) Compute(worshipService WeeklyEntries, sundaySchool WeeklyEntries) float64 {
+	if f == AttendanceWorshipService || f == "" {
+		return CalculateAverage(worshipService.Counts())
+	}
+
+	type week struct{ worship, school int }
+	var dates []string
+	weeks := map[string]*week{}
+	weekOf := func(date string) *week {
+		if weeks[date] == nil {
+			weeks[date] = &week{}
+			dates = append(dates, date)
+		}
+		return weeks[date]
+	}
+
+	for _, entry := range worshipService {
+		if entry.Held() {
+			weekOf(entry.Date).worship += *entry.Count
+		}
+	}
+	for _, entry := range sundaySchool {
+		if entry.Held() {
+			weekOf(entry.Date).school += *entry.Count
+		}
+	}
+
+	weekly := make([]int, 0, len(dates))
+	for _, date := range dates {
+		w := weeks[date]
+		if f == AttendanceHigherOf {
+			weekly = append(weekly, int(math.Max(float64(w.worship), float64(w.school))))
+		} else {
+			weekly = append(weekly, w.worship+w.school)
+		}
+	}
+
+	return CalculateAverage(weekly)
+}
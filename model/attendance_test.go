@@ -0,0 +1,95 @@
+package model
+
+import "testing"
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func dated(date string, count *int) WeeklyEntry {
+	return WeeklyEntry{Date: date, Count: count}
+}
+
+func TestParseAttendanceFormula(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    AttendanceFormula
+		wantErr bool
+	}{
+		{"", DefaultAttendanceFormula, false},
+		{"worship_service", AttendanceWorshipService, false},
+		{"combined", AttendanceCombined, false},
+		{"higher_of", AttendanceHigherOf, false},
+		{"Combined", "", true},
+		{"average", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAttendanceFormula(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseAttendanceFormula(%q) = %q, %v; want %q, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestParseAttendanceCheck(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    AttendanceCheck
+		wantErr bool
+	}{
+		{"", AttendanceCheckReject, false},
+		{"reject", AttendanceCheckReject, false},
+		{"warn", AttendanceCheckWarn, false},
+		{"warning", "", true},
+		{"WARN", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAttendanceCheck(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseAttendanceCheck(%q) = %q, %v; want %q, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestAttendanceFormulaCompute(t *testing.T) {
+	worship := WeeklyEntries{
+		dated("2024-03-03", intPtr(40)),
+		dated("2024-03-10", intPtr(50)),
+		dated("2024-03-17", nil),
+		dated("2024-03-24", intPtr(45)),
+	}
+	school := WeeklyEntries{
+		dated("2024-03-03", intPtr(20)),
+		dated("2024-03-10", intPtr(60)),
+		dated("2024-03-17", intPtr(30)),
+	}
+
+	tests := []struct {
+		formula AttendanceFormula
+		want    float64
+	}{
+		// (40 + 50 + 45) / 3
+		{AttendanceWorshipService, 45},
+		{"", 45},
+		// (60 + 110 + 30 + 45) / 4, rounded
+		{AttendanceCombined, 61},
+		// (40 + 60 + 30 + 45) / 4, rounded
+		{AttendanceHigherOf, 44},
+	}
+
+	for _, tt := range tests {
+		if got := tt.formula.Compute(worship, school); got != tt.want {
+			t.Errorf("%q.Compute() = %v, want %v", tt.formula, got, tt.want)
+		}
+	}
+}
+
+func TestAttendanceFormulaComputeNothingHeld(t *testing.T) {
+	for _, formula := range []AttendanceFormula{AttendanceWorshipService, AttendanceCombined, AttendanceHigherOf} {
+		if got := formula.Compute(WeeklyEntries{dated("2024-03-03", nil)}, nil); got != 0 {
+			t.Errorf("%q.Compute() with no meetings = %v, want 0", formula, got)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type ChurchStatus string
+
+const (
+	ChurchStatusPreachingPoint ChurchStatus = "preaching_point"
+	ChurchStatusOrganized      ChurchStatus = "organized_church"
+)
+
+type Church struct {
+	Id            int          `json:"id"`
+	Name          string       `json:"name"`
+	Address       string       `json:"address"`
+	Barangay      string       `json:"barangay"`
+	Municipality  string       `json:"municipality"`
+	Province      string       `json:"province"`
+	DateOrganized *time.Time   `json:"date_organized,omitempty"`
+	Status        ChurchStatus `json:"status"`
+	AreaId        int          `json:"area_id"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import "fmt"
+
+// FinanceGrouping selects what the rows of a finance summary are grouped by.
+type FinanceGrouping string
+
+const (
+	FinanceByChurch FinanceGrouping = "church"
+	FinanceByArea   FinanceGrouping = "area"
+	// FinanceByMonth puts every church the user may see into one row per month.
+	FinanceByMonth FinanceGrouping = "month"
+
+	DefaultFinanceGrouping = FinanceByChurch
+)
+
+// ParseFinanceGrouping validates a group_by value. An empty value selects
+// DefaultFinanceGrouping.
+func ParseFinanceGrouping(value string) (FinanceGrouping, error) {
+	switch grouping := FinanceGrouping(value); grouping {
+	case "":
+		return DefaultFinanceGrouping, nil
+	case FinanceByChurch, FinanceByArea, FinanceByMonth:
+		return grouping, nil
+	default:
+		return "", fmt.Errorf("unknown finance grouping %q", value)
+	}
+}
+
+// FinanceSummary is what one church or area gave in one month. GroupId and
+// GroupName are empty when grouped by month.
+type FinanceSummary struct {
+	GroupId      int
+	GroupName    string
+	MonthOf      Period
+	Reports      int
+	Tithes       Money
+	Offerings    Money
+	SpecialGifts Money
+	Total        Money
+	// Change is Total minus the previous month's, nil when the group has no
+	// report for the previous month.
+	Change        *Money
+	ChangePercent *float64
+	// YearToDate is the running Total from January of the same year.
+	YearToDate Money
+}
@@ -0,0 +1,13 @@
+package model
+
+// ChurchFilter narrows the churches returned by ChurchRepository.FindAll.
+// A zero AreaId means every area; otherwise descendants of the area are included.
+type ChurchFilter struct {
+	AreaId int
+}
+
+// WorkerFilter narrows the workers returned by WorkerRepository.FindAll.
+// A zero AreaId means every area; otherwise descendants of the area are included.
+type WorkerFilter struct {
+	AreaId int
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is used for amounts sent without a currency code.
+const DefaultCurrency = "PHP"
+
+var (
+	ErrInvalidAmount    = errors.New("amount must be a decimal with at most two fractional digits")
+	ErrCurrencyMismatch = errors.New("tithes, offerings and special gifts must use the same currency")
+)
+
+// Money is an amount in minor units (centavos for PHP) with its ISO 4217
+// currency code. Every supported currency has two fractional digits. In JSON
+// it is written as {"amount": "1234.50", "currency": "PHP"}; the amount may
+// also be sent as a number.
+type Money struct {
+	Minor    int64
+	Currency string
+}
+
+func NewMoney(minor int64, currency string) Money {
+	return Money{Minor: minor, Currency: currencyOrDefault(currency)}
+}
+
+func currencyOrDefault(currency string) string {
+	if currency == "" {
+		return DefaultCurrency
+	}
+	return currency
+}
+
+// ParseAmount converts a decimal such as "1234.5" into minor units without
+// going through float64, so no centavos are lost.
+func ParseAmount(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" || len(fraction) > 2 || strings.ContainsAny(whole+fraction, "+-eE ") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	minor, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	if negative {
+		minor = -minor
+	}
+	return minor, nil
+}
+
+// Amount formats the value as a decimal with two fractional digits.
+func (m Money) Amount() string {
+	sign := ""
+	minor := m.Minor
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, minor/100, minor%100)
+}
+
+// Add returns the sum of two amounts. The currency of m is kept.
+func (m Money) Add(other Money) Money {
+	return NewMoney(m.Minor+other.Minor, m.Currency)
+}
+
+func (m Money) String() string {
+	return m.Amount() + " " + currencyOrDefault(m.Currency)
+}
+
+type moneyJSON struct {
+	Amount   json.Number `json:"amount"`
+	Currency string      `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.Amount(), currencyOrDefault(m.Currency)})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var value moneyJSON
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	minor, err := ParseAmount(value.Amount.String())
+	if err != nil {
+		return err
+	}
+
+	*m = NewMoney(minor, strings.ToUpper(value.Currency))
+	return nil
+}
+
+// ReportFinances are the monthly totals given by a church, kept apart from
+// the attendance figures.
+type ReportFinances struct {
+	Tithes       Money `json:"tithes"`
+	Offerings    Money `json:"offerings"`
+	SpecialGifts Money `json:"special_gifts"`
+}
+
+func (f ReportFinances) Total() Money {
+	return f.Tithes.Add(f.Offerings).Add(f.SpecialGifts)
+}
+
+// Currency returns the currency shared by the three amounts, or an error when they differ.
+func (f ReportFinances) Currency() (string, error) {
+	currency := currencyOrDefault(f.Tithes.Currency)
+	for _, amount := range []Money{f.Offerings, f.SpecialGifts} {
+		if currencyOrDefault(amount.Currency) != currency {
+			return "", ErrCurrencyMismatch
+		}
+	}
+	return currency, nil
+}
+
+// SetCurrency gives the three amounts the currency stored with the report.
+func (f *ReportFinances) SetCurrency(currency string) {
+	f.Tithes.Currency = currency
+	f.Offerings.Currency = currency
+	f.SpecialGifts.Currency = currency
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"1234", 123400, false},
+		{"1234.5", 123450, false},
+		{"1234.05", 123405, false},
+		{" 12.30 ", 1230, false},
+		{"1.", 100, false},
+		{"-7.25", -725, false},
+		{"", 0, true},
+		{".5", 0, true},
+		{"1.234", 0, true},
+		{"1e3", 0, true},
+		{"+5", 0, true},
+		{"--5", 0, true},
+		{"1 000", 0, true},
+		{"abc", 0, true},
+		{"99999999999999999999", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseAmount(%q) = %d, %v; want %d, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("ParseAmount(%q) error %v does not wrap ErrInvalidAmount", tt.value, err)
+		}
+	}
+}
+
+func TestMoneyAmount(t *testing.T) {
+	tests := []struct {
+		minor int64
+		want  string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{123450, "1234.50"},
+		{-725, "-7.25"},
+	}
+
+	for _, tt := range tests {
+		if got := NewMoney(tt.minor, "").Amount(); got != tt.want {
+			t.Errorf("Money{%d}.Amount() = %q, want %q", tt.minor, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const periodLayout = "2006-01"
+
+var ErrInvalidPeriod = errors.New("period must be in the form YYYY-MM")
+
+// Period is the calendar month a report covers. It is written as YYYY-MM in
+// JSON and stored as the first day of the month in a DATE column.
+type Period struct {
+	Year  int
+	Month time.Month
+}
+
+func NewPeriod(year int, month time.Month) Period {
+	return Period{Year: year, Month: month}
+}
+
+// PeriodOf returns the period containing t.
+func PeriodOf(t time.Time) Period {
+	return Period{Year: t.Year(), Month: t.Month()}
+}
+
+// ParsePeriod parses a strict YYYY-MM value such as "2024-03".
+func ParsePeriod(value string) (Period, error) {
+	t, err := time.Parse(periodLayout, value)
+	if err != nil || len(value) != len(periodLayout) {
+		return Period{}, fmt.Errorf("%w: %q", ErrInvalidPeriod, value)
+	}
+	return PeriodOf(t), nil
+}
+
+func (p Period) IsZero() bool {
+	return p.Year == 0 && p.Month == 0
+}
+
+// Start returns midnight UTC on the first day of the period.
+func (p Period) Start() time.Time {
+	return time.Date(p.Year, p.Month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// AddMonths returns the period n months later, or earlier when n is negative.
+func (p Period) AddMonths(n int) Period {
+	return PeriodOf(p.Start().AddDate(0, n, 0))
+}
+
+func (p Period) Before(other Period) bool {
+	return p.Start().Before(other.Start())
+}
+
+// Sundays returns every Sunday of the period.
+func (p Period) Sundays() []time.Time {
+	start := p.Start()
+	first := start.AddDate(0, 0, (7-int(start.Weekday()))%7)
+
+	var sundays []time.Time
+	for day := first; day.Month() == p.Month; day = day.AddDate(0, 0, 7) {
+		sundays = append(sundays, day)
+	}
+	return sundays
+}
+
+// UndatedDates returns the distinct dates n undated weekly counts are placed
+// on, in order: the Sundays of the period, then for counts beyond the last
+// Sunday the latest days of the month not yet used, going backwards. Fewer
+// than n dates are returned only when n exceeds the days of the month.
+func (p Period) UndatedDates(n int) []time.Time {
+	dates := p.Sundays()
+	if n <= len(dates) {
+		return dates[:n]
+	}
+
+	for day := p.AddMonths(1).Start().AddDate(0, 0, -1); len(dates) < n && day.Month() == p.Month; day = day.AddDate(0, 0, -1) {
+		if day.Weekday() != time.Sunday {
+			dates = append(dates, day)
+		}
+	}
+	return dates
+}
+
+// Contains reports whether t falls within the period.
+func (p Period) Contains(t time.Time) bool {
+	return PeriodOf(t) == p
+}
+
+func (p Period) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.Start().Format(periodLayout)
+}
+
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+func (p *Period) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ErrInvalidPeriod
+	}
+	parsed, err := ParsePeriod(value)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// Value implements driver.Valuer
+func (p Period) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+	return p.Start(), nil
+}
+
+// Scan implements sql.Scanner
+func (p *Period) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*p = Period{}
+	case time.Time:
+		*p = PeriodOf(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Period", src)
+	}
+	return nil
+}
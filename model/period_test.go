@@ -0,0 +1,127 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Period
+		wantErr bool
+	}{
+		{"2024-03", NewPeriod(2024, time.March), false},
+		{"1999-12", NewPeriod(1999, time.December), false},
+		{"2024-3", Period{}, true},
+		{"2024-13", Period{}, true},
+		{"2024-03-01", Period{}, true},
+		{"03/2024", Period{}, true},
+		{"March 2024", Period{}, true},
+		{"", Period{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePeriod(tt.value)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidPeriod) {
+				t.Errorf("ParsePeriod(%q) error = %v, want ErrInvalidPeriod", tt.value, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParsePeriod(%q) = %v, %v; want %v", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestPeriodJSON(t *testing.T) {
+	var p Period
+	if err := p.UnmarshalJSON([]byte(`"2024-03"`)); err != nil || p != NewPeriod(2024, time.March) {
+		t.Fatalf("UnmarshalJSON = %v, %v", p, err)
+	}
+	data, err := p.MarshalJSON()
+	if err != nil || string(data) != `"2024-03"` {
+		t.Errorf("MarshalJSON = %s, %v", data, err)
+	}
+	if err := p.UnmarshalJSON([]byte(`202403`)); !errors.Is(err, ErrInvalidPeriod) {
+		t.Errorf("UnmarshalJSON(number) error = %v, want ErrInvalidPeriod", err)
+	}
+}
+
+func formatDates(dates []time.Time) []string {
+	formatted := make([]string, len(dates))
+	for i, date := range dates {
+		formatted[i] = date.Format(DateLayout)
+	}
+	return formatted
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPeriodSundays(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   []string
+	}{
+		// Starts on a Friday.
+		{NewPeriod(2024, time.March), []string{"2024-03-03", "2024-03-10", "2024-03-17", "2024-03-24", "2024-03-31"}},
+		// Starts on a Sunday.
+		{NewPeriod(2026, time.February), []string{"2026-02-01", "2026-02-08", "2026-02-15", "2026-02-22"}},
+		// Leap-year February with five Sundays.
+		{NewPeriod(2004, time.February), []string{"2004-02-01", "2004-02-08", "2004-02-15", "2004-02-22", "2004-02-29"}},
+	}
+
+	for _, tt := range tests {
+		if got := formatDates(tt.period.Sundays()); !equalStrings(got, tt.want) {
+			t.Errorf("%v.Sundays() = %v, want %v", tt.period, got, tt.want)
+		}
+	}
+}
+
+func TestPeriodUndatedDates(t *testing.T) {
+	tests := []struct {
+		period Period
+		n      int
+		want   []string
+	}{
+		{NewPeriod(2024, time.March), 0, []string{}},
+		{NewPeriod(2024, time.March), 2, []string{"2024-03-03", "2024-03-10"}},
+		// Four Sundays, so the fifth and sixth counts take the last days.
+		{NewPeriod(2026, time.February), 6, []string{"2026-02-01", "2026-02-08", "2026-02-15", "2026-02-22", "2026-02-28", "2026-02-27"}},
+		// Ends on a Sunday, so the sixth count takes the Saturday before it.
+		{NewPeriod(2024, time.March), 6, []string{"2024-03-03", "2024-03-10", "2024-03-17", "2024-03-24", "2024-03-31", "2024-03-30"}},
+	}
+
+	for _, tt := range tests {
+		got := formatDates(tt.period.UndatedDates(tt.n))
+		if !equalStrings(got, tt.want) {
+			t.Errorf("%v.UndatedDates(%d) = %v, want %v", tt.period, tt.n, got, tt.want)
+		}
+	}
+
+	if got := NewPeriod(2026, time.February).UndatedDates(40); len(got) != 28 {
+		t.Errorf("UndatedDates(40) in February returned %d dates, want 28", len(got))
+	}
+}
+
+func TestPeriodContains(t *testing.T) {
+	p := NewPeriod(2024, time.March)
+	if !p.Contains(time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Error("March 2024 should contain March 31")
+	}
+	if p.Contains(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("March 2024 should not contain April 1")
+	}
+}
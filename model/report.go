@@ -1,78 +1,134 @@
 package model
 
-import (
-	"math"
-	"time"
-)
+import "time"
 
 type Report struct {
-	Id                              int       `json:"id"`
-	MonthOf                         string    `json:"month_of"`
-	WorkerName                      string    `json:"worker_name"`
-	AreaOfAssignment                string    `json:"area_of_assignment"`
-	NameOfChurch                    string    `json:"name_of_church"`
-	WorshipService                  []int     `json:"worship_service,omitempty"`
-	SundaySchool                    []int     `json:"sunday_school,omitempty"`
-	PrayerMeetings                  []int     `json:"prayer_meetings,omitempty"`
-	BibleStudies                    []int     `json:"bible_studies,omitempty"`
-	MensFellowships                 []int     `json:"mens_fellowships,omitempty"`
-	WomensFellowships               []int     `json:"womens_fellowships,omitempty"`
-	YouthFellowships                []int     `json:"youth_fellowships,omitempty"`
-	ChildFellowships                []int     `json:"child_fellowships,omitempty"`
-	Outreach                        []int     `json:"outreach,omitempty"`
-	TrainingOrSeminars              []int     `json:"training_or_seminars,omitempty"`
-	LeadershipConferences           []int     `json:"leadership_conferences,omitempty"`
-	LeadershipTraining              []int     `json:"leadership_training,omitempty"`
-	Others                          []int     `json:"others,omitempty"`
-	FamilyDays                      []int     `json:"family_days,omitempty"`
-	TithesAndOfferings              []int     `json:"tithes_and_offerings,omitempty"`
-	AverageAttendance               float64   `json:"average_attendance"`
-	WorshipServiceAvg               float64   `json:"worship_service_average,omitempty"`
-	SundaySchoolAvg                 float64   `json:"sunday_school_average,omitempty"`
-	PrayerMeetingsAvg               float64   `json:"prayer_meetings_average,omitempty"`
-	BibleStudiesAvg                 float64   `json:"bible_studies_average,omitempty"`
-	MensFellowshipsAvg              float64   `json:"mens_fellowships_average,omitempty"`
-	WomensFellowshipsAvg            float64   `json:"womens_fellowships_average,omitempty"`
-	YouthFellowshipsAvg             float64   `json:"youth_fellowships_average,omitempty"`
-	ChildFellowshipsAvg             float64   `json:"child_fellowships_average,omitempty"`
-	OutreachAvg                     float64   `json:"outreach_average,omitempty"`
-	TrainingOrSeminarsAvg           float64   `json:"training_or_seminars_average,omitempty"`
-	LeadershipConferencesAvg        float64   `json:"leadership_conferences_average,omitempty"`
-	LeadershipTrainingAvg           float64   `json:"leadership_training_average,omitempty"`
-	OthersAvg                       float64   `json:"others_average,omitempty"`
-	FamilyDaysAvg                   float64   `json:"family_days_average,omitempty"`
-	TithesAndOfferingsAvg           float64   `json:"tithes_and_offerings_average,omitempty"`
-	HomeVisited                     []int     `json:"home_visited,omitempty"`
-	BibleStudyOrGroupLed            []int     `json:"bible_study_or_group_led,omitempty"`
-	SermonOrMessagePreached         []int     `json:"sermon_or_message_preached,omitempty"`
-	PersonNewlyContacted            []int     `json:"person_newly_contacted,omitempty"`
-	PersonFollowedUp                []int     `json:"person_followed_up,omitempty"`
-	PersonLedToChrist               []int     `json:"person_led_to_christ,omitempty"`
-	Names                           []string  `json:"names,omitempty"`
-	HomeVisitedAvg                  float64   `json:"home_visited_average,omitempty"`
-	BibleStudyOrGroupLedAvg         float64   `json:"bible_study_or_group_led_average,omitempty"`
-	SermonOrMessagePreachedAvg      float64   `json:"sermon_or_message_preached_average,omitempty"`
-	PersonNewlyContactedAvg         float64   `json:"person_newly_contacted_average,omitempty"`
-	PersonFollowedUpAvg             float64   `json:"person_followed_up_average,omitempty"`
-	PersonLedToChristAvg            float64   `json:"person_led_to_christ_average,omitempty"`
-	NarrativeReport                 string    `json:"narrative_report"`
-	ChallengesAndProblemEncountered string    `json:"challenges_and_problem_encountered"`
-	PrayerRequest                   string    `json:"prayer_request"`
-	CreatedAt                       time.Time `json:"created_at"`
-	UpdatedAt                       time.Time `json:"updated_at"`
+	Id                              int            `json:"id"`
+	UserId                          int            `json:"user_id"`
+	MonthOf                         Period         `json:"month_of"`
+	WorkerId                        int            `json:"worker_id"`
+	WorkerName                      string         `json:"worker_name"`
+	AreaId                          int            `json:"area_id"`
+	AreaOfAssignment                string         `json:"area_of_assignment"`
+	ChurchId                        int            `json:"church_id"`
+	NameOfChurch                    string         `json:"name_of_church"`
+	Status                          ReportStatus   `json:"status"`
+	WorshipService                  WeeklyEntries  `json:"worship_service,omitempty"`
+	SundaySchool                    WeeklyEntries  `json:"sunday_school,omitempty"`
+	PrayerMeetings                  WeeklyEntries  `json:"prayer_meetings,omitempty"`
+	BibleStudies                    WeeklyEntries  `json:"bible_studies,omitempty"`
+	MensFellowships                 WeeklyEntries  `json:"mens_fellowships,omitempty"`
+	WomensFellowships               WeeklyEntries  `json:"womens_fellowships,omitempty"`
+	YouthFellowships                WeeklyEntries  `json:"youth_fellowships,omitempty"`
+	ChildFellowships                WeeklyEntries  `json:"child_fellowships,omitempty"`
+	Outreach                        WeeklyEntries  `json:"outreach,omitempty"`
+	TrainingOrSeminars              WeeklyEntries  `json:"training_or_seminars,omitempty"`
+	LeadershipConferences           WeeklyEntries  `json:"leadership_conferences,omitempty"`
+	LeadershipTraining              WeeklyEntries  `json:"leadership_training,omitempty"`
+	Others                          WeeklyEntries  `json:"others,omitempty"`
+	FamilyDays                      WeeklyEntries  `json:"family_days,omitempty"`
+	AverageAttendance               float64        `json:"average_attendance"`
+	Finances                        ReportFinances `json:"finances"`
+	WorshipServiceAvg               float64        `json:"worship_service_average,omitempty"`
+	SundaySchoolAvg                 float64        `json:"sunday_school_average,omitempty"`
+	PrayerMeetingsAvg               float64        `json:"prayer_meetings_average,omitempty"`
+	BibleStudiesAvg                 float64        `json:"bible_studies_average,omitempty"`
+	MensFellowshipsAvg              float64        `json:"mens_fellowships_average,omitempty"`
+	WomensFellowshipsAvg            float64        `json:"womens_fellowships_average,omitempty"`
+	YouthFellowshipsAvg             float64        `json:"youth_fellowships_average,omitempty"`
+	ChildFellowshipsAvg             float64        `json:"child_fellowships_average,omitempty"`
+	OutreachAvg                     float64        `json:"outreach_average,omitempty"`
+	TrainingOrSeminarsAvg           float64        `json:"training_or_seminars_average,omitempty"`
+	LeadershipConferencesAvg        float64        `json:"leadership_conferences_average,omitempty"`
+	LeadershipTrainingAvg           float64        `json:"leadership_training_average,omitempty"`
+	OthersAvg                       float64        `json:"others_average,omitempty"`
+	FamilyDaysAvg                   float64        `json:"family_days_average,omitempty"`
+	HomeVisited                     WeeklyEntries  `json:"home_visited,omitempty"`
+	BibleStudyOrGroupLed            WeeklyEntries  `json:"bible_study_or_group_led,omitempty"`
+	SermonOrMessagePreached         WeeklyEntries  `json:"sermon_or_message_preached,omitempty"`
+	PersonNewlyContacted            WeeklyEntries  `json:"person_newly_contacted,omitempty"`
+	PersonFollowedUp                WeeklyEntries  `json:"person_followed_up,omitempty"`
+	PersonLedToChrist               WeeklyEntries  `json:"person_led_to_christ,omitempty"`
+	Names                           []string       `json:"names,omitempty"`
+	HomeVisitedAvg                  float64        `json:"home_visited_average,omitempty"`
+	BibleStudyOrGroupLedAvg         float64        `json:"bible_study_or_group_led_average,omitempty"`
+	SermonOrMessagePreachedAvg      float64        `json:"sermon_or_message_preached_average,omitempty"`
+	PersonNewlyContactedAvg         float64        `json:"person_newly_contacted_average,omitempty"`
+	PersonFollowedUpAvg             float64        `json:"person_followed_up_average,omitempty"`
+	PersonLedToChristAvg            float64        `json:"person_led_to_christ_average,omitempty"`
+	NarrativeReport                 string         `json:"narrative_report"`
+	ChallengesAndProblemEncountered string         `json:"challenges_and_problem_encountered"`
+	PrayerRequest                   string         `json:"prayer_request"`
+	CreatedAt                       time.Time      `json:"created_at"`
+	UpdatedAt                       time.Time      `json:"updated_at"`
 }
 
-// Helper function to calculate average attendance
-func CalculateAverage(attendance []int) float64 {
-	if len(attendance) == 0 {
-		return 0
+// ReportActivity is one weekly activity of a report: its JSON and column
+// name, its weekly entries and its stored average.
+type ReportActivity struct {
+	Key     string
+	Entries *WeeklyEntries
+	Average *float64
+}
+
+// Activities lists the weekly activities of the report in column order.
+func (r *Report) Activities() []ReportActivity {
+	return []ReportActivity{
+		{Key: "worship_service", Entries: &r.WorshipService, Average: &r.WorshipServiceAvg},
+		{Key: "sunday_school", Entries: &r.SundaySchool, Average: &r.SundaySchoolAvg},
+		{Key: "prayer_meetings", Entries: &r.PrayerMeetings, Average: &r.PrayerMeetingsAvg},
+		{Key: "bible_studies", Entries: &r.BibleStudies, Average: &r.BibleStudiesAvg},
+		{Key: "mens_fellowships", Entries: &r.MensFellowships, Average: &r.MensFellowshipsAvg},
+		{Key: "womens_fellowships", Entries: &r.WomensFellowships, Average: &r.WomensFellowshipsAvg},
+		{Key: "youth_fellowships", Entries: &r.YouthFellowships, Average: &r.YouthFellowshipsAvg},
+		{Key: "child_fellowships", Entries: &r.ChildFellowships, Average: &r.ChildFellowshipsAvg},
+		{Key: "outreach", Entries: &r.Outreach, Average: &r.OutreachAvg},
+		{Key: "training_or_seminars", Entries: &r.TrainingOrSeminars, Average: &r.TrainingOrSeminarsAvg},
+		{Key: "leadership_conferences", Entries: &r.LeadershipConferences, Average: &r.LeadershipConferencesAvg},
+		{Key: "leadership_training", Entries: &r.LeadershipTraining, Average: &r.LeadershipTrainingAvg},
+		{Key: "others", Entries: &r.Others, Average: &r.OthersAvg},
+		{Key: "family_days", Entries: &r.FamilyDays, Average: &r.FamilyDaysAvg},
+		{Key: "home_visited", Entries: &r.HomeVisited, Average: &r.HomeVisitedAvg},
+		{Key: "bible_study_or_group_led", Entries: &r.BibleStudyOrGroupLed, Average: &r.BibleStudyOrGroupLedAvg},
+		{Key: "sermon_or_message_preached", Entries: &r.SermonOrMessagePreached, Average: &r.SermonOrMessagePreachedAvg},
+		{Key: "person_newly_contacted", Entries: &r.PersonNewlyContacted, Average: &r.PersonNewlyContactedAvg},
+		{Key: "person_followed_up", Entries: &r.PersonFollowedUp, Average: &r.PersonFollowedUpAvg},
+		{Key: "person_led_to_christ", Entries: &r.PersonLedToChrist, Average: &r.PersonLedToChristAvg},
+	}
+}
+
+// ActivityKeys returns the keys of every weekly activity in column order.
+func ActivityKeys() []string {
+	activities := (&Report{}).Activities()
+	keys := make([]string, len(activities))
+	for i, activity := range activities {
+		keys[i] = activity.Key
 	}
+	return keys
+}
+
+// IsActivityKey reports whether key names a weekly activity.
+func IsActivityKey(key string) bool {
+	for _, activity := range ActivityKeys() {
+		if activity == key {
+			return true
+		}
+	}
+	return false
+}
 
-	total := 0
-	for _, a := range attendance {
-		total += a
+// ComputeAverages fills in AverageAttendance and the per-activity averages from
+// the weekly counts. It must be called before a report is saved so the stored
+// averages stay in sync.
+func (r *Report) ComputeAverages(formula AttendanceFormula) {
+	r.AverageAttendance = formula.Compute(r.WorshipService, r.SundaySchool)
+	for _, activity := range r.Activities() {
+		*activity.Average = CalculateAverage(activity.Entries.Counts())
 	}
+}
 
-	average := float64(total) / float64(len(attendance))
-	return math.Round(average)
+// CalculateAverage averages the counts of the sessions that were held; pass
+// WeeklyEntries.Counts so weeks without a meeting do not lower the average.
+func CalculateAverage(attendance []int) float64 {
+	return CalculateStats(attendance, 0).MeanRounded
 }
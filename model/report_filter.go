@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// ReportFilter narrows, orders and pages the reports returned by ReportRepository.FindAll.
+// Zero values mean "no restriction". Area filters include every descendant area.
+type ReportFilter struct {
+	UserId      int
+	ScopeAreaId int
+	AreaId      int
+	WorkerId    int
+	ChurchId    int
+	Status      ReportStatus
+	MonthFrom   Period
+	MonthTo     Period
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	SortBy      string
+	SortDesc    bool
+	Limit       int
+	Offset      int
+}
@@ -0,0 +1,80 @@
+package model
+
+import "time"
+
+// ReportStatus is where a report stands in the submission workflow. A report
+// starts as a draft, is submitted by its worker, taken under review by a
+// supervisor and then either returned for correction or approved.
+type ReportStatus string
+
+const (
+	StatusDraft       ReportStatus = "draft"
+	StatusSubmitted   ReportStatus = "submitted"
+	StatusUnderReview ReportStatus = "under_review"
+	StatusReturned    ReportStatus = "returned"
+	StatusApproved    ReportStatus = "approved"
+)
+
+// Locked reports whether a report in this status may no longer be edited or
+// deleted. An approved report has to be reopened first.
+func (s ReportStatus) Locked() bool {
+	return s == StatusApproved
+}
+
+// ReportAction is a transition of the submission workflow.
+type ReportAction string
+
+const (
+	ActionSubmit  ReportAction = "submit"
+	ActionReview  ReportAction = "review"
+	ActionReturn  ReportAction = "return"
+	ActionApprove ReportAction = "approve"
+	// ActionReopen sends an approved report back for correction.
+	ActionReopen ReportAction = "reopen"
+)
+
+var reportTransitions = map[ReportAction]struct {
+	from []ReportStatus
+	to   ReportStatus
+}{
+	ActionSubmit:  {from: []ReportStatus{StatusDraft, StatusReturned}, to: StatusSubmitted},
+	ActionReview:  {from: []ReportStatus{StatusSubmitted}, to: StatusUnderReview},
+	ActionReturn:  {from: []ReportStatus{StatusUnderReview}, to: StatusReturned},
+	ActionApprove: {from: []ReportStatus{StatusUnderReview}, to: StatusApproved},
+	ActionReopen:  {from: []ReportStatus{StatusApproved}, to: StatusReturned},
+}
+
+// Next returns the status the action moves a report in status from to. The
+// second return value is false when the action does not apply to that status.
+func (a ReportAction) Next(from ReportStatus) (ReportStatus, bool) {
+	transition, ok := reportTransitions[a]
+	if !ok {
+		return "", false
+	}
+	for _, status := range transition.from {
+		if status == from {
+			return transition.to, true
+		}
+	}
+	return "", false
+}
+
+// NeedsNote reports whether the action must say why it was taken, so the
+// worker knows what to correct.
+func (a ReportAction) NeedsNote() bool {
+	return a == ActionReturn || a == ActionReopen
+}
+
+// ReportStatusChange records one transition of a report: who took it, when
+// and why. ActorName is empty once the acting user has been deleted.
+type ReportStatusChange struct {
+	Id        int
+	ReportId  int
+	Action    ReportAction
+	From      ReportStatus
+	To        ReportStatus
+	ActorId   int
+	ActorName string
+	Note      string
+	ChangedAt time.Time
+}
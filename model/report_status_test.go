@@ -0,0 +1,61 @@
+package model
+
+import "testing"
+
+func TestReportActionNext(t *testing.T) {
+	tests := []struct {
+		action ReportAction
+		from   ReportStatus
+		want   ReportStatus
+		ok     bool
+	}{
+		{ActionSubmit, StatusDraft, StatusSubmitted, true},
+		{ActionSubmit, StatusReturned, StatusSubmitted, true},
+		{ActionSubmit, StatusSubmitted, "", false},
+		{ActionSubmit, StatusApproved, "", false},
+		{ActionReview, StatusSubmitted, StatusUnderReview, true},
+		{ActionReview, StatusDraft, "", false},
+		{ActionReturn, StatusUnderReview, StatusReturned, true},
+		{ActionReturn, StatusSubmitted, "", false},
+		{ActionApprove, StatusUnderReview, StatusApproved, true},
+		{ActionApprove, StatusSubmitted, "", false},
+		{ActionApprove, StatusApproved, "", false},
+		{ActionReopen, StatusApproved, StatusReturned, true},
+		{ActionReopen, StatusReturned, "", false},
+		{"delete", StatusDraft, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.action.Next(tt.from)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%q.Next(%q) = %q, %v; want %q, %v", tt.action, tt.from, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestReportActionNeedsNote(t *testing.T) {
+	tests := []struct {
+		action ReportAction
+		want   bool
+	}{
+		{ActionSubmit, false},
+		{ActionReview, false},
+		{ActionReturn, true},
+		{ActionApprove, false},
+		{ActionReopen, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.action.NeedsNote(); got != tt.want {
+			t.Errorf("%q.NeedsNote() = %v, want %v", tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestReportStatusLocked(t *testing.T) {
+	for _, status := range []ReportStatus{StatusDraft, StatusSubmitted, StatusUnderReview, StatusReturned, StatusApproved} {
+		if got, want := status.Locked(), status == StatusApproved; got != want {
+			t.Errorf("%q.Locked() = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"math"
+	"sort"
+)
+
+// Stats summarises the sessions of one activity in a report. Sessions is left
+// out of the JSON because report responses already carry it as sessions_held.
+type Stats struct {
+	Sessions    int     `json:"-"`
+	Total       int     `json:"total"`
+	Mean        float64 `json:"mean"`
+	MeanRounded float64 `json:"mean_rounded"`
+	Median      float64 `json:"median"`
+	Min         int     `json:"min"`
+	Max         int     `json:"max"`
+}
+
+// CalculateStats summarises the counts of the sessions that were held.
+// MeanRounded is Mean rounded half away from zero to precision decimal places.
+// All fields are zero when there were no sessions.
+func CalculateStats(counts []int, precision int) Stats {
+	if len(counts) == 0 {
+		return Stats{}
+	}
+
+	sorted := append([]int(nil), counts...)
+	sort.Ints(sorted)
+
+	stats := Stats{
+		Sessions: len(sorted),
+		Min:      sorted[0],
+		Max:      sorted[len(sorted)-1],
+	}
+	for _, count := range sorted {
+		stats.Total += count
+	}
+
+	stats.Mean = float64(stats.Total) / float64(stats.Sessions)
+	stats.MeanRounded = RoundTo(stats.Mean, precision)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		stats.Median = float64(sorted[middle])
+	} else {
+		stats.Median = float64(sorted[middle-1]+sorted[middle]) / 2
+	}
+
+	return stats
+}
+
+// RoundTo rounds value half away from zero to the given number of decimal places.
+func RoundTo(value float64, precision int) float64 {
+	if precision <= 0 {
+		return math.Round(value)
+	}
+	scale := math.Pow(10, float64(precision))
+	return math.Round(value*scale) / scale
+}
+
+// Stats returns the statistics of every activity keyed by its JSON name.
+func (r *Report) Stats(precision int) map[string]Stats {
+	stats := make(map[string]Stats, len(r.Activities()))
+	for _, activity := range r.Activities() {
+		stats[activity.Key] = CalculateStats(activity.Entries.Counts(), precision)
+	}
+	return stats
+}
+
+// PercentChange returns the growth from previous to current in percent,
+// rounded to one decimal place, or nil when previous is zero.
+func PercentChange(previous float64, current float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	change := RoundTo((current-previous)/previous*100, 1)
+	return &change
+}
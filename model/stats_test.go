@@ -0,0 +1,87 @@
+package model
+
+import "testing"
+
+func TestCalculateStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		counts    []int
+		precision int
+		want      Stats
+	}{
+		{"no sessions", nil, 1, Stats{}},
+		{"one session", []int{12}, 1, Stats{Sessions: 1, Total: 12, Mean: 12, MeanRounded: 12, Median: 12, Min: 12, Max: 12}},
+		{
+			"odd count",
+			[]int{30, 10, 20},
+			1,
+			Stats{Sessions: 3, Total: 60, Mean: 20, MeanRounded: 20, Median: 20, Min: 10, Max: 30},
+		},
+		{
+			"even count",
+			[]int{4, 1, 3, 2},
+			0,
+			Stats{Sessions: 4, Total: 10, Mean: 2.5, MeanRounded: 3, Median: 2.5, Min: 1, Max: 4},
+		},
+		{
+			"rounded mean",
+			[]int{10, 10, 11},
+			2,
+			Stats{Sessions: 3, Total: 31, Mean: 31.0 / 3, MeanRounded: 10.33, Median: 10, Min: 10, Max: 11},
+		},
+		{
+			"zero attendance",
+			[]int{0, 0},
+			1,
+			Stats{Sessions: 2, Total: 0, Mean: 0, MeanRounded: 0, Median: 0, Min: 0, Max: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := CalculateStats(tt.counts, tt.precision); got != tt.want {
+			t.Errorf("%s: CalculateStats(%v, %d) = %+v, want %+v", tt.name, tt.counts, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateStatsKeepsInput(t *testing.T) {
+	counts := []int{3, 1, 2}
+	CalculateStats(counts, 0)
+	if counts[0] != 3 || counts[1] != 1 || counts[2] != 2 {
+		t.Errorf("CalculateStats reordered its input: %v", counts)
+	}
+}
+
+func TestRoundTo(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		want      float64
+	}{
+		{2.5, 0, 3},
+		{-2.5, 0, -3},
+		{2.449, 1, 2.4},
+		{2.45, 1, 2.5},
+		{1.005, 3, 1.005},
+		{10.333333, 2, 10.33},
+		{7.6, -1, 8},
+	}
+
+	for _, tt := range tests {
+		if got := RoundTo(tt.value, tt.precision); got != tt.want {
+			t.Errorf("RoundTo(%v, %d) = %v, want %v", tt.value, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	if got := PercentChange(0, 10); got != nil {
+		t.Errorf("PercentChange(0, 10) = %v, want nil", *got)
+	}
+	if got := PercentChange(80, 100); got == nil || *got != 25 {
+		t.Errorf("PercentChange(80, 100) = %v, want 25", got)
+	}
+	if got := PercentChange(3, 2); got == nil || *got != -33.3 {
+		t.Errorf("PercentChange(3, 2) = %v, want -33.3", got)
+	}
+}
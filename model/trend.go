@@ -0,0 +1,39 @@
+package model
+
+// TrendMetricAverageAttendance is the trend metric for a report's overall
+// average attendance. Every activity key is a trend metric as well.
+const TrendMetricAverageAttendance = "average_attendance"
+
+// IsTrendMetric reports whether metric may be charted by the trend endpoint.
+func IsTrendMetric(metric string) bool {
+	return metric == TrendMetricAverageAttendance || IsActivityKey(metric)
+}
+
+// TrendPoint is one month of a trend. Value is the sum of the stored averages
+// of the month's reports and is nil when no report was filed. PreviousMonth
+// and PreviousYear are the values of the month before and of the same month
+// a year earlier.
+type TrendPoint struct {
+	MonthOf       Period
+	Reports       int
+	Value         *float64
+	PreviousMonth *float64
+	PreviousYear  *float64
+}
+
+// TrendDelta is the change between two values of a trend.
+type TrendDelta struct {
+	Change  float64  `json:"change"`
+	Percent *float64 `json:"percent"`
+}
+
+// DeltaOf returns the change from previous to current, or nil when either is missing.
+func DeltaOf(previous *float64, current *float64) *TrendDelta {
+	if previous == nil || current == nil {
+		return nil
+	}
+	return &TrendDelta{
+		Change:  RoundTo(*current-*previous, 2),
+		Percent: PercentChange(*previous, *current),
+	}
+}
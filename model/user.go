@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Role string
+
+const (
+	RoleWorker         Role = "worker"
+	RoleAreaSupervisor Role = "area_supervisor"
+	RoleAdmin          Role = "admin"
+	RoleAuditor        Role = "auditor"
+)
+
+type User struct {
+	Id        int       `json:"id"`
+	Username  string    `json:"username"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Password  string    `json:"-"`
+	Role      Role      `json:"role"`
+	AreaId    int       `json:"area_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+const DateLayout = "2006-01-02"
+
+// WeeklyEntry is one week's count of an activity, dated to the day it was held.
+// A nil Count means the activity was not held that week, which is different
+// from a meeting nobody attended (a count of 0).
+type WeeklyEntry struct {
+	Date  string `json:"date" binding:"omitempty,datetime=2006-01-02"`
+	Count *int   `json:"count" binding:"omitempty,min=0"`
+}
+
+// Held reports whether the activity took place that week.
+func (e WeeklyEntry) Held() bool {
+	return e.Count != nil
+}
+
+// WeeklyEntries is the list of weekly counts for one activity of a report.
+// It also accepts the older undated form, a bare array of counts such as
+// [30, null, 35], leaving Date empty so the service can assign one.
+type WeeklyEntries []WeeklyEntry
+
+func (e *WeeklyEntries) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*e = nil
+		return nil
+	}
+
+	var counts []*int
+	if err := json.Unmarshal(data, &counts); err == nil {
+		entries := make(WeeklyEntries, len(counts))
+		for i, count := range counts {
+			entries[i] = WeeklyEntry{Count: count}
+		}
+		*e = entries
+		return nil
+	}
+
+	var entries []WeeklyEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	*e = entries
+	return nil
+}
+
+// Counts returns the counts of the weeks the activity was held, in date order.
+func (e WeeklyEntries) Counts() []int {
+	counts := make([]int, 0, len(e))
+	for _, entry := range e {
+		if entry.Held() {
+			counts = append(counts, *entry.Count)
+		}
+	}
+	return counts
+}
+
+// SessionsHeld returns how many weeks the activity took place.
+func (e WeeklyEntries) SessionsHeld() int {
+	return len(e.Counts())
+}
+
+// SortByDate orders the entries by date. Undated entries keep their relative order.
+func (e WeeklyEntries) SortByDate() {
+	sort.SliceStable(e, func(i, j int) bool {
+		return e[i].Date < e[j].Date
+	})
+}
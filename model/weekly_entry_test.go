@@ -0,0 +1,68 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWeeklyEntriesUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    WeeklyEntries
+		wantErr bool
+	}{
+		{"null", `null`, nil, false},
+		{"empty", `[]`, WeeklyEntries{}, false},
+		{"bare counts", `[30, null, 0]`, WeeklyEntries{{Count: intPtr(30)}, {}, {Count: intPtr(0)}}, false},
+		{
+			"dated entries",
+			`[{"date": "2024-03-03", "count": 30}, {"date": "2024-03-10", "count": null}]`,
+			WeeklyEntries{dated("2024-03-03", intPtr(30)), dated("2024-03-10", nil)},
+			false,
+		},
+		{"dated entry without a count", `[{"date": "2024-03-03"}]`, WeeklyEntries{dated("2024-03-03", nil)}, false},
+		{"string", `"30"`, nil, true},
+		{"strings", `["30"]`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got WeeklyEntries
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, want error %v", tt.data, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) || (got == nil) != (tt.want == nil) {
+				t.Fatalf("Unmarshal(%s) = %#v, want %#v", tt.data, got, tt.want)
+			}
+			for i := range got {
+				if got[i].Date != tt.want[i].Date || !sameCount(got[i].Count, tt.want[i].Count) {
+					t.Errorf("entry %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func sameCount(a *int, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func TestWeeklyEntriesCounts(t *testing.T) {
+	entries := WeeklyEntries{dated("2024-03-03", intPtr(30)), dated("2024-03-10", nil), dated("2024-03-17", intPtr(0))}
+
+	counts := entries.Counts()
+	if len(counts) != 2 || counts[0] != 30 || counts[1] != 0 {
+		t.Errorf("Counts() = %v, want [30 0]", counts)
+	}
+	if got := entries.SessionsHeld(); got != 2 {
+		t.Errorf("SessionsHeld() = %d, want 2", got)
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+type WorkerStatus string
+
+const (
+	WorkerStatusActive   WorkerStatus = "active"
+	WorkerStatusInactive WorkerStatus = "inactive"
+)
+
+type Worker struct {
+	Id            int          `json:"id"`
+	Name          string       `json:"name"`
+	ContactNumber string       `json:"contact_number"`
+	Email         string       `json:"email"`
+	Status        WorkerStatus `json:"status"`
+	UserId        int          `json:"user_id"`
+	AreaId        int          `json:"area_id"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"reports/model"
+)
+
+type AreaRepository interface {
+	Save(ctx context.Context, area *model.Area) error
+	Update(ctx context.Context, area *model.Area) error
+	Delete(ctx context.Context, areaId int) error
+	FindById(ctx context.Context, areaId int) (*model.Area, error)
+	FindAll(ctx context.Context) ([]model.Area, error)
+	FindSubtreeIds(ctx context.Context, areaId int) ([]int, error)
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reports/helper"
+	"reports/model"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrAreaNotFound = errors.New("area not found")
+	ErrAreaInUse    = errors.New("area still has sub-areas, churches, workers, users or reports")
+)
+
+type AreaRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func NewAreaRepository(Db *sql.DB) AreaRepository {
+	return &AreaRepositoryImpl{Db: Db}
+}
+
+// Delete implements AreaRepository
+func (r *AreaRepositoryImpl) Delete(ctx context.Context, areaId int) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		DELETE FROM areas
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL, areaId)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return ErrAreaInUse
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FindAll implements AreaRepository
+func (r *AreaRepositoryImpl) FindAll(ctx context.Context) ([]model.Area, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			level,
+			parent_id,
+			created_at,
+			updated_at
+		FROM areas
+		ORDER BY parent_id NULLS FIRST, name
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var areas []model.Area
+
+	for result.Next() {
+		var area model.Area
+		var parentId sql.NullInt64
+
+		err := result.Scan(
+			&area.Id,
+			&area.Name,
+			&area.Level,
+			&parentId,
+			&area.CreatedAt,
+			&area.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		area.ParentId = int(parentId.Int64)
+
+		areas = append(areas, area)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return areas, nil
+}
+
+// FindById implements AreaRepository
+func (r *AreaRepositoryImpl) FindById(ctx context.Context, areaId int) (*model.Area, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			level,
+			parent_id,
+			created_at,
+			updated_at
+		FROM areas
+		WHERE
+			id = $1
+	`
+
+	area := &model.Area{}
+	var parentId sql.NullInt64
+
+	err = tx.QueryRowContext(ctx, rawSQL, areaId).Scan(
+		&area.Id,
+		&area.Name,
+		&area.Level,
+		&parentId,
+		&area.CreatedAt,
+		&area.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAreaNotFound
+		}
+		return nil, err
+	}
+	area.ParentId = int(parentId.Int64)
+
+	return area, nil
+}
+
+// FindSubtreeIds implements AreaRepository
+func (r *AreaRepositoryImpl) FindSubtreeIds(ctx context.Context, areaId int) ([]int, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT id FROM area_subtree($1)
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL, areaId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var areaIds []int
+
+	for result.Next() {
+		var id int
+		if err := result.Scan(&id); err != nil {
+			return nil, err
+		}
+		areaIds = append(areaIds, id)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return areaIds, nil
+}
+
+// Save implements AreaRepository
+func (r *AreaRepositoryImpl) Save(ctx context.Context, area *model.Area) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		INSERT INTO areas (
+			name,
+			level,
+			parent_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, NULLIF($3, 0), $4, $5)
+		RETURNING id
+	`
+
+	err = tx.QueryRowContext(ctx, rawSQL,
+		area.Name,
+		area.Level,
+		area.ParentId,
+		area.CreatedAt,
+		area.UpdatedAt,
+	).Scan(&area.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update implements AreaRepository
+func (r *AreaRepositoryImpl) Update(ctx context.Context, area *model.Area) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE areas SET
+			name = $1,
+			level = $2,
+			parent_id = NULLIF($3, 0),
+			updated_at = $4
+		WHERE
+			id = $5
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL,
+		area.Name,
+		area.Level,
+		area.ParentId,
+		time.Now(),
+		area.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reports/helper"
+	"reports/model"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrChurchNotFound = errors.New("church not found")
+	ErrChurchInUse    = errors.New("church still has reports")
+)
+
+type ChurchRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func NewChurchRepository(Db *sql.DB) ChurchRepository {
+	return &ChurchRepositoryImpl{Db: Db}
+}
+
+// Delete implements ChurchRepository
+func (r *ChurchRepositoryImpl) Delete(ctx context.Context, churchId int) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		DELETE FROM churches
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL, churchId)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return ErrChurchInUse
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FindAll implements ChurchRepository
+func (r *ChurchRepositoryImpl) FindAll(ctx context.Context, filter model.ChurchFilter) ([]model.Church, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			address,
+			barangay,
+			municipality,
+			province,
+			date_organized,
+			status,
+			area_id,
+			created_at,
+			updated_at
+		FROM churches
+		WHERE
+			$1 = 0 OR area_id IN (SELECT id FROM area_subtree($1))
+		ORDER BY name
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL, filter.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var churches []model.Church
+
+	for result.Next() {
+		var church model.Church
+		var (
+			address       sql.NullString
+			barangay      sql.NullString
+			municipality  sql.NullString
+			province      sql.NullString
+			dateOrganized sql.NullTime
+			areaId        sql.NullInt64
+		)
+
+		err := result.Scan(
+			&church.Id,
+			&church.Name,
+			&address,
+			&barangay,
+			&municipality,
+			&province,
+			&dateOrganized,
+			&church.Status,
+			&areaId,
+			&church.CreatedAt,
+			&church.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		church.Address = address.String
+		church.Barangay = barangay.String
+		church.Municipality = municipality.String
+		church.Province = province.String
+		church.AreaId = int(areaId.Int64)
+		if dateOrganized.Valid {
+			church.DateOrganized = &dateOrganized.Time
+		}
+
+		churches = append(churches, church)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return churches, nil
+}
+
+// FindById implements ChurchRepository
+func (r *ChurchRepositoryImpl) FindById(ctx context.Context, churchId int) (*model.Church, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			address,
+			barangay,
+			municipality,
+			province,
+			date_organized,
+			status,
+			area_id,
+			created_at,
+			updated_at
+		FROM churches
+		WHERE
+			id = $1
+	`
+
+	church := &model.Church{}
+	var (
+		address       sql.NullString
+		barangay      sql.NullString
+		municipality  sql.NullString
+		province      sql.NullString
+		dateOrganized sql.NullTime
+		areaId        sql.NullInt64
+	)
+
+	err = tx.QueryRowContext(ctx, rawSQL, churchId).Scan(
+		&church.Id,
+		&church.Name,
+		&address,
+		&barangay,
+		&municipality,
+		&province,
+		&dateOrganized,
+		&church.Status,
+		&areaId,
+		&church.CreatedAt,
+		&church.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrChurchNotFound
+		}
+		return nil, err
+	}
+	church.Address = address.String
+	church.Barangay = barangay.String
+	church.Municipality = municipality.String
+	church.Province = province.String
+	church.AreaId = int(areaId.Int64)
+	if dateOrganized.Valid {
+		church.DateOrganized = &dateOrganized.Time
+	}
+
+	return church, nil
+}
+
+// Save implements ChurchRepository
+func (r *ChurchRepositoryImpl) Save(ctx context.Context, church *model.Church) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		INSERT INTO churches (
+			name,
+			address,
+			barangay,
+			municipality,
+			province,
+			date_organized,
+			status,
+			area_id,
+			created_at,
+			updated_at
+		) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, $7, NULLIF($8, 0), $9, $10)
+		RETURNING id
+	`
+
+	err = tx.QueryRowContext(ctx, rawSQL,
+		church.Name,
+		church.Address,
+		church.Barangay,
+		church.Municipality,
+		church.Province,
+		church.DateOrganized,
+		church.Status,
+		church.AreaId,
+		church.CreatedAt,
+		church.UpdatedAt,
+	).Scan(&church.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update implements ChurchRepository
+func (r *ChurchRepositoryImpl) Update(ctx context.Context, church *model.Church) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE churches SET
+			name = $1,
+			address = NULLIF($2, ''),
+			barangay = NULLIF($3, ''),
+			municipality = NULLIF($4, ''),
+			province = NULLIF($5, ''),
+			date_organized = $6,
+			status = $7,
+			area_id = NULLIF($8, 0),
+			updated_at = $9
+		WHERE
+			id = $10
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL,
+		church.Name,
+		church.Address,
+		church.Barangay,
+		church.Municipality,
+		church.Province,
+		church.DateOrganized,
+		church.Status,
+		church.AreaId,
+		time.Now(),
+		church.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
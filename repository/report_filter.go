@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"fmt"
+	"reports/model"
+	"strings"
+)
+
+// reportSortColumns whitelists the fields GET /api may be sorted by.
+var reportSortColumns = map[string]string{
+	"id":                 "r.id",
+	"month_of":           "r.month_of",
+	"worker_name":        "w.name",
+	"name_of_church":     "c.name",
+	"area_of_assignment": "a.name",
+	"average_attendance": "r.average_attendance",
+	"status":             "r.status",
+	"created_at":         "r.created_at",
+	"updated_at":         "r.updated_at",
+}
+
+// IsReportSortField reports whether field may be used as ReportFilter.SortBy.
+func IsReportSortField(field string) bool {
+	_, ok := reportSortColumns[field]
+	return ok
+}
+
+// reportWhereClause turns a filter into a WHERE clause over the reports r,
+// workers w, churches c and areas a join, with its positional arguments.
+func reportWhereClause(filter model.ReportFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, strings.ReplaceAll(condition, "?", fmt.Sprintf("$%d", len(args))))
+	}
+
+	if filter.UserId != 0 {
+		add("r.user_id = ?", filter.UserId)
+	}
+	if filter.ScopeAreaId != 0 {
+		add("r.area_id IN (SELECT id FROM area_subtree(?))", filter.ScopeAreaId)
+	}
+	if filter.AreaId != 0 {
+		add("r.area_id IN (SELECT id FROM area_subtree(?))", filter.AreaId)
+	}
+	if filter.WorkerId != 0 {
+		add("r.worker_id = ?", filter.WorkerId)
+	}
+	if filter.ChurchId != 0 {
+		add("r.church_id = ?", filter.ChurchId)
+	}
+	if filter.Status != "" {
+		add("r.status = ?", filter.Status)
+	}
+	if !filter.MonthFrom.IsZero() {
+		add("r.month_of >= ?", filter.MonthFrom)
+	}
+	if !filter.MonthTo.IsZero() {
+		add("r.month_of <= ?", filter.MonthTo)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		add("r.created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		add("r.created_at < ?", filter.CreatedTo)
+	}
+	if !filter.UpdatedFrom.IsZero() {
+		add("r.updated_at >= ?", filter.UpdatedFrom)
+	}
+	if !filter.UpdatedTo.IsZero() {
+		add("r.updated_at < ?", filter.UpdatedTo)
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// reportOrderClause returns the ORDER BY clause for the filter, always breaking ties by id
+// so that pages are stable.
+func reportOrderClause(filter model.ReportFilter) string {
+	column, ok := reportSortColumns[filter.SortBy]
+	if !ok {
+		column = "r.id"
+	}
+
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	if column == "r.id" {
+		return fmt.Sprintf("ORDER BY r.id %s", direction)
+	}
+
+	return fmt.Sprintf("ORDER BY %s %s, r.id %s", column, direction, direction)
+}
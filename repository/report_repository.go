@@ -7,8 +7,24 @@ import (
 
 type ReportRepository interface {
 	Save(ctx context.Context, report *model.Report) error
+	// Upsert saves the report, or replaces the content of the one already filed
+	// for the same worker, church and month. It reports whether a new report
+	// was created, and returns ErrReportLocked if the existing one is approved.
+	Upsert(ctx context.Context, report *model.Report) (bool, error)
+	SaveAll(ctx context.Context, reports []*model.Report) error
+	// Update and Delete return ErrReportLocked if the report is approved.
 	Update(ctx context.Context, report *model.Report) error
+	UpdateAverages(ctx context.Context, report *model.Report) error
 	Delete(ctx context.Context, reportId int) error
 	FindById(ctx context.Context, reportId int) (*model.Report, error)
-	FindAll(ctx context.Context) ([]model.Report, error)
+	FindIdByPeriod(ctx context.Context, workerId int, churchId int, period model.Period) (int, error)
+	FindAll(ctx context.Context, filter model.ReportFilter) ([]model.Report, error)
+	// Each calls fn for every report matching the filter, in filter order,
+	// stopping at the first error fn returns.
+	Each(ctx context.Context, filter model.ReportFilter, fn func(report *model.Report) error) error
+	Count(ctx context.Context, filter model.ReportFilter) (int, error)
+	// ChangeStatus moves the report from change.From to change.To, touching
+	// its updated_at, and records the change, setting its Id and ChangedAt.
+	ChangeStatus(ctx context.Context, change *model.ReportStatusChange) error
+	FindStatusChanges(ctx context.Context, reportId int) ([]model.ReportStatusChange, error)
 }
@@ -5,11 +5,47 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reports/helper"
 	"reports/model"
 	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrReportNotFound  = errors.New("report not found")
+	ErrReportDuplicate = errors.New("a report already exists for this worker, church and month")
+	// ErrReportStatusChanged is returned by ChangeStatus when the report is no
+	// longer in the status the change starts from.
+	ErrReportStatusChanged = errors.New("the report's status was changed by someone else")
+	// ErrReportLocked is returned when a write would change an approved report.
+	ErrReportLocked = errors.New("the report is approved; reopen it before making changes")
 )
 
+// ReportBatchError is returned by SaveAll when the report at Index could not
+// be saved. None of the batch is saved.
+type ReportBatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *ReportBatchError) Error() string {
+	return fmt.Sprintf("report %d of the batch: %v", e.Index+1, e.Err)
+}
+
+func (e *ReportBatchError) Unwrap() error {
+	return e.Err
+}
+
+// reportPeriodConstraint is the unique constraint on (worker_id, church_id, month_of).
+const reportPeriodConstraint = "reports_worker_church_month_key"
+
+func isReportDuplicate(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == reportPeriodConstraint
+}
+
 type ReportRepositoryImpl struct {
 	Db *sql.DB
 }
@@ -18,7 +54,9 @@ func NewReportRepository(Db *sql.DB) ReportRepository {
 	return &ReportRepositoryImpl{Db: Db}
 }
 
-// Delete implements BookRepository
+// Delete implements ReportRepository. An approved report is left in place
+// and ErrReportLocked returned, even if it was approved after the caller
+// last read it.
 func (r *ReportRepositoryImpl) Delete(ctx context.Context, reportId int) error {
 	tx, err := r.Db.BeginTx(ctx, nil)
 	if err != nil {
@@ -28,73 +66,161 @@ func (r *ReportRepositoryImpl) Delete(ctx context.Context, reportId int) error {
 
 	rawSQL := `
 		DELETE FROM reports
-		WHERE id = $1
+		WHERE id = $1 AND status <> 'approved'
 	`
 
-	_, err = tx.ExecContext(ctx, rawSQL, reportId)
+	result, err := tx.ExecContext(ctx, rawSQL, reportId)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return lockedUnlessAffected(ctx, tx, result, reportId)
 }
 
-// FindAll implements BookRepository
-func (r *ReportRepositoryImpl) FindAll(ctx context.Context) ([]model.Report, error) {
+// lockedUnlessAffected explains a write guarded by status <> 'approved' that
+// changed no row: ErrReportNotFound if the report is gone, ErrReportLocked if
+// it is approved.
+func lockedUnlessAffected(ctx context.Context, tx *sql.Tx, result sql.Result, reportId int) error {
+	affected, err := result.RowsAffected()
+	if err != nil || affected > 0 {
+		return err
+	}
+
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM reports WHERE id = $1)`, reportId).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrReportNotFound
+	}
+	return ErrReportLocked
+}
+
+// Count implements ReportRepository
+func (r *ReportRepositoryImpl) Count(ctx context.Context, filter model.ReportFilter) (int, error) {
 	tx, err := r.Db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT count(*)
+		FROM reports r
+		JOIN workers w ON w.id = r.worker_id
+		JOIN churches c ON c.id = r.church_id
+		JOIN areas a ON a.id = r.area_id
+	`
+
+	where, args := reportWhereClause(filter)
+	rawSQL += where
+
+	var total int
+	if err := tx.QueryRowContext(ctx, rawSQL, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// Each implements ReportRepository. Rows are read one at a time, so the
+// matching reports are never all held in memory.
+func (r *ReportRepositoryImpl) Each(ctx context.Context, filter model.ReportFilter, fn func(report *model.Report) error) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
 	defer helper.CommitOrRollback(tx)
 
 	rawSQL := `
         SELECT 
-            id,
-            month_of,
-            worker_name,
-            area_of_assignment,
-            name_of_church,
-            created_at,
-            updated_at,
-            worship_service,
-            sunday_school,
-            prayer_meetings,
-            bible_studies,
-            mens_fellowships,
-            womens_fellowships,
-            youth_fellowships,
-            child_fellowships,
-            outreach,
-            training_or_seminars,
-            leadership_conferences,
-            leadership_training,
-            others,
-            family_days,
-            tithes_and_offerings,
-            average_attendance,
-            home_visited,
-            bible_study_or_group_led,
-            sermon_or_message_preached,
-            person_newly_contacted,
-            person_followed_up,
-            person_led_to_christ,
-            names,
-			narrative_report,
-			challenges_and_problem_encountered,
-			prayer_request
-        FROM reports
+            r.id,
+            r.user_id,
+            r.month_of,
+            r.worker_id,
+            w.name AS worker_name,
+            r.area_id,
+            a.name AS area_of_assignment,
+            r.church_id,
+            c.name AS name_of_church,
+            r.created_at,
+            r.updated_at,
+            r.status,
+            r.worship_service,
+            r.sunday_school,
+            r.prayer_meetings,
+            r.bible_studies,
+            r.mens_fellowships,
+            r.womens_fellowships,
+            r.youth_fellowships,
+            r.child_fellowships,
+            r.outreach,
+            r.training_or_seminars,
+            r.leadership_conferences,
+            r.leadership_training,
+            r.others,
+            r.family_days,
+            r.tithes_minor,
+            r.offerings_minor,
+            r.special_gifts_minor,
+            r.currency,
+            r.average_attendance,
+            r.home_visited,
+            r.bible_study_or_group_led,
+            r.sermon_or_message_preached,
+            r.person_newly_contacted,
+            r.person_followed_up,
+            r.person_led_to_christ,
+            r.names,
+			r.narrative_report,
+			r.challenges_and_problem_encountered,
+			r.prayer_request,
+			COALESCE(r.worship_service_avg, 0),
+			COALESCE(r.sunday_school_avg, 0),
+			COALESCE(r.prayer_meetings_avg, 0),
+			COALESCE(r.bible_studies_avg, 0),
+			COALESCE(r.mens_fellowships_avg, 0),
+			COALESCE(r.womens_fellowships_avg, 0),
+			COALESCE(r.youth_fellowships_avg, 0),
+			COALESCE(r.child_fellowships_avg, 0),
+			COALESCE(r.outreach_avg, 0),
+			COALESCE(r.training_or_seminars_avg, 0),
+			COALESCE(r.leadership_conferences_avg, 0),
+			COALESCE(r.leadership_training_avg, 0),
+			COALESCE(r.others_avg, 0),
+			COALESCE(r.family_days_avg, 0),
+			COALESCE(r.home_visited_avg, 0),
+			COALESCE(r.bible_study_or_group_led_avg, 0),
+			COALESCE(r.sermon_or_message_preached_avg, 0),
+			COALESCE(r.person_newly_contacted_avg, 0),
+			COALESCE(r.person_followed_up_avg, 0),
+			COALESCE(r.person_led_to_christ_avg, 0)
+        FROM reports r
+        JOIN workers w ON w.id = r.worker_id
+        JOIN churches c ON c.id = r.church_id
+        JOIN areas a ON a.id = r.area_id
     `
-	result, err := tx.QueryContext(ctx, rawSQL)
+
+	where, args := reportWhereClause(filter)
+	rawSQL += where + " " + reportOrderClause(filter)
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit, filter.Offset)
+		rawSQL += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	result, err := tx.QueryContext(ctx, rawSQL, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer result.Close()
 
-	var reports []model.Report
-
 	for result.Next() {
 		var report model.Report
 		var (
+			userId                    sql.NullInt64
+			currency                  string
 			worshipServiceJSON        []byte
 			sundaySchoolJSON          []byte
 			prayerMeetingsJSON        []byte
@@ -109,7 +235,6 @@ func (r *ReportRepositoryImpl) FindAll(ctx context.Context) ([]model.Report, err
 			leadershipTrainingJSON    []byte
 			othersJSON                []byte
 			familyDaysJSON            []byte
-			tithesAndOfferingsJSON    []byte
 			homeVisitedJSON           []byte
 			bibleStudyOrGroupLedJSON  []byte
 			sermonOrMessageJSON       []byte
@@ -122,12 +247,17 @@ func (r *ReportRepositoryImpl) FindAll(ctx context.Context) ([]model.Report, err
 		// Scan the row into variables
 		err := result.Scan(
 			&report.Id,
+			&userId,
 			&report.MonthOf,
+			&report.WorkerId,
 			&report.WorkerName,
+			&report.AreaId,
 			&report.AreaOfAssignment,
+			&report.ChurchId,
 			&report.NameOfChurch,
 			&report.CreatedAt,
 			&report.UpdatedAt,
+			&report.Status,
 			&worshipServiceJSON,
 			&sundaySchoolJSON,
 			&prayerMeetingsJSON,
@@ -142,7 +272,10 @@ func (r *ReportRepositoryImpl) FindAll(ctx context.Context) ([]model.Report, err
 			&leadershipTrainingJSON,
 			&othersJSON,
 			&familyDaysJSON,
-			&tithesAndOfferingsJSON,
+			&report.Finances.Tithes.Minor,
+			&report.Finances.Offerings.Minor,
+			&report.Finances.SpecialGifts.Minor,
+			&currency,
 			&report.AverageAttendance,
 			&homeVisitedJSON,
 			&bibleStudyOrGroupLedJSON,
@@ -154,158 +287,218 @@ func (r *ReportRepositoryImpl) FindAll(ctx context.Context) ([]model.Report, err
 			&report.NarrativeReport,
 			&report.ChallengesAndProblemEncountered,
 			&report.PrayerRequest,
+			&report.WorshipServiceAvg,
+			&report.SundaySchoolAvg,
+			&report.PrayerMeetingsAvg,
+			&report.BibleStudiesAvg,
+			&report.MensFellowshipsAvg,
+			&report.WomensFellowshipsAvg,
+			&report.YouthFellowshipsAvg,
+			&report.ChildFellowshipsAvg,
+			&report.OutreachAvg,
+			&report.TrainingOrSeminarsAvg,
+			&report.LeadershipConferencesAvg,
+			&report.LeadershipTrainingAvg,
+			&report.OthersAvg,
+			&report.FamilyDaysAvg,
+			&report.HomeVisitedAvg,
+			&report.BibleStudyOrGroupLedAvg,
+			&report.SermonOrMessagePreachedAvg,
+			&report.PersonNewlyContactedAvg,
+			&report.PersonFollowedUpAvg,
+			&report.PersonLedToChristAvg,
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		report.UserId = int(userId.Int64)
+		report.Finances.SetCurrency(currency)
 
 		// Unmarshal JSONB fields into their respective slices
 		if worshipServiceJSON != nil {
 			if err := json.Unmarshal(worshipServiceJSON, &report.WorshipService); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if sundaySchoolJSON != nil {
 			if err := json.Unmarshal(sundaySchoolJSON, &report.SundaySchool); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if prayerMeetingsJSON != nil {
 			if err := json.Unmarshal(prayerMeetingsJSON, &report.PrayerMeetings); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if bibleStudiesJSON != nil {
 			if err := json.Unmarshal(bibleStudiesJSON, &report.BibleStudies); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if mensFellowshipsJSON != nil {
 			if err := json.Unmarshal(mensFellowshipsJSON, &report.MensFellowships); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if womensFellowshipsJSON != nil {
 			if err := json.Unmarshal(womensFellowshipsJSON, &report.WomensFellowships); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if youthFellowshipsJSON != nil {
 			if err := json.Unmarshal(youthFellowshipsJSON, &report.YouthFellowships); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if childFellowshipsJSON != nil {
 			if err := json.Unmarshal(childFellowshipsJSON, &report.ChildFellowships); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if outreachJSON != nil {
 			if err := json.Unmarshal(outreachJSON, &report.Outreach); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if trainingOrSeminarsJSON != nil {
 			if err := json.Unmarshal(trainingOrSeminarsJSON, &report.TrainingOrSeminars); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if leadershipConferencesJSON != nil {
 			if err := json.Unmarshal(leadershipConferencesJSON, &report.LeadershipConferences); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if leadershipTrainingJSON != nil {
 			if err := json.Unmarshal(leadershipTrainingJSON, &report.LeadershipTraining); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if othersJSON != nil {
 			if err := json.Unmarshal(othersJSON, &report.Others); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if familyDaysJSON != nil {
 			if err := json.Unmarshal(familyDaysJSON, &report.FamilyDays); err != nil {
-				return nil, err
-			}
-		}
-
-		if tithesAndOfferingsJSON != nil {
-			if err := json.Unmarshal(tithesAndOfferingsJSON, &report.TithesAndOfferings); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		// Unmarshal JSONB fields into []int for additional fields
 		if homeVisitedJSON != nil {
 			if err := json.Unmarshal(homeVisitedJSON, &report.HomeVisited); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if bibleStudyOrGroupLedJSON != nil {
 			if err := json.Unmarshal(bibleStudyOrGroupLedJSON, &report.BibleStudyOrGroupLed); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if sermonOrMessageJSON != nil {
 			if err := json.Unmarshal(sermonOrMessageJSON, &report.SermonOrMessagePreached); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if personNewlyContactedJSON != nil {
 			if err := json.Unmarshal(personNewlyContactedJSON, &report.PersonNewlyContacted); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if personFollowedUpJSON != nil {
 			if err := json.Unmarshal(personFollowedUpJSON, &report.PersonFollowedUp); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if personLedToChristJSON != nil {
 			if err := json.Unmarshal(personLedToChristJSON, &report.PersonLedToChrist); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if namesJSON != nil {
 			if err := json.Unmarshal(namesJSON, &report.Names); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
-		// Append the populated report to the slice
-		reports = append(reports, report)
+		if err := fn(&report); err != nil {
+			return err
+		}
 	}
 
 	// Check for any error during result iteration
 	if err := result.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindAll implements ReportRepository
+func (r *ReportRepositoryImpl) FindAll(ctx context.Context, filter model.ReportFilter) ([]model.Report, error) {
+	reports := []model.Report{}
+	err := r.Each(ctx, filter, func(report *model.Report) error {
+		reports = append(reports, *report)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return reports, nil
 }
 
-// FindById implements BookRepository
+// FindIdByPeriod implements ReportRepository
+func (r *ReportRepositoryImpl) FindIdByPeriod(ctx context.Context, workerId int, churchId int, period model.Period) (int, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT id
+		FROM reports
+		WHERE
+			worker_id = $1
+			AND church_id = $2
+			AND month_of = $3
+	`
+
+	var reportId int
+	err = tx.QueryRowContext(ctx, rawSQL, workerId, churchId, period).Scan(&reportId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrReportNotFound
+		}
+		return 0, err
+	}
+
+	return reportId, nil
+}
+
+// FindById implements ReportRepository
 func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*model.Report, error) {
 	tx, err := r.Db.Begin()
 	if err != nil {
@@ -315,42 +508,73 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 
 	rawSQL := `
 		SELECT 
-			id,
-			month_of,
-			worker_name,
-			area_of_assignment,
-			name_of_church,
-			created_at,
-			updated_at,
-			worship_service,
-			sunday_school,
-			prayer_meetings,
-			bible_studies,
-			mens_fellowships,
-			womens_fellowships,
-			youth_fellowships,
-			child_fellowships,
-			outreach,
-			training_or_seminars,
-			leadership_conferences,
-			leadership_training,
-			others,
-			family_days,
-			tithes_and_offerings,
-			average_attendance,
-			home_visited,
-			bible_study_or_group_led,
-			sermon_or_message_preached,
-			person_newly_contacted,
-			person_followed_up,
-			person_led_to_christ,
-			names,
-			narrative_report,
-			challenges_and_problem_encountered,
-			prayer_request
-		FROM reports
+			r.id,
+			r.user_id,
+			r.month_of,
+			r.worker_id,
+			w.name AS worker_name,
+			r.area_id,
+			a.name AS area_of_assignment,
+			r.church_id,
+			c.name AS name_of_church,
+			r.created_at,
+			r.updated_at,
+			r.status,
+			r.worship_service,
+			r.sunday_school,
+			r.prayer_meetings,
+			r.bible_studies,
+			r.mens_fellowships,
+			r.womens_fellowships,
+			r.youth_fellowships,
+			r.child_fellowships,
+			r.outreach,
+			r.training_or_seminars,
+			r.leadership_conferences,
+			r.leadership_training,
+			r.others,
+			r.family_days,
+			r.tithes_minor,
+			r.offerings_minor,
+			r.special_gifts_minor,
+			r.currency,
+			r.average_attendance,
+			r.home_visited,
+			r.bible_study_or_group_led,
+			r.sermon_or_message_preached,
+			r.person_newly_contacted,
+			r.person_followed_up,
+			r.person_led_to_christ,
+			r.names,
+			r.narrative_report,
+			r.challenges_and_problem_encountered,
+			r.prayer_request,
+			COALESCE(r.worship_service_avg, 0),
+			COALESCE(r.sunday_school_avg, 0),
+			COALESCE(r.prayer_meetings_avg, 0),
+			COALESCE(r.bible_studies_avg, 0),
+			COALESCE(r.mens_fellowships_avg, 0),
+			COALESCE(r.womens_fellowships_avg, 0),
+			COALESCE(r.youth_fellowships_avg, 0),
+			COALESCE(r.child_fellowships_avg, 0),
+			COALESCE(r.outreach_avg, 0),
+			COALESCE(r.training_or_seminars_avg, 0),
+			COALESCE(r.leadership_conferences_avg, 0),
+			COALESCE(r.leadership_training_avg, 0),
+			COALESCE(r.others_avg, 0),
+			COALESCE(r.family_days_avg, 0),
+			COALESCE(r.home_visited_avg, 0),
+			COALESCE(r.bible_study_or_group_led_avg, 0),
+			COALESCE(r.sermon_or_message_preached_avg, 0),
+			COALESCE(r.person_newly_contacted_avg, 0),
+			COALESCE(r.person_followed_up_avg, 0),
+			COALESCE(r.person_led_to_christ_avg, 0)
+		FROM reports r
+		JOIN workers w ON w.id = r.worker_id
+		JOIN churches c ON c.id = r.church_id
+		JOIN areas a ON a.id = r.area_id
 		WHERE 
-			id = $1
+			r.id = $1
 	`
 
 	// Query the database
@@ -361,6 +585,8 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 
 	// Variables to hold JSONB data
 	var (
+		userId                          sql.NullInt64
+		currency                        string
 		worshipServiceJSON              []byte
 		sundaySchoolJSON                []byte
 		prayerMeetingsJSON              []byte
@@ -375,7 +601,6 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 		leadershipTrainingJSON          []byte
 		othersJSON                      []byte
 		familyDaysJSON                  []byte
-		tithesAndOfferingsJSON          []byte
 		homeVisitedJSON                 []byte
 		bibleStudyOrGroupLedJSON        []byte
 		sermonOrMessagePreachedJSON     []byte
@@ -391,12 +616,17 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 	// Scan the row into variables
 	err = row.Scan(
 		&report.Id,
+		&userId,
 		&report.MonthOf,
+		&report.WorkerId,
 		&report.WorkerName,
+		&report.AreaId,
 		&report.AreaOfAssignment,
+		&report.ChurchId,
 		&report.NameOfChurch,
 		&report.CreatedAt,
 		&report.UpdatedAt,
+		&report.Status,
 		&worshipServiceJSON,
 		&sundaySchoolJSON,
 		&prayerMeetingsJSON,
@@ -411,7 +641,10 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 		&leadershipTrainingJSON,
 		&othersJSON,
 		&familyDaysJSON,
-		&tithesAndOfferingsJSON,
+		&report.Finances.Tithes.Minor,
+		&report.Finances.Offerings.Minor,
+		&report.Finances.SpecialGifts.Minor,
+		&currency,
 		&report.AverageAttendance,
 		&homeVisitedJSON,
 		&bibleStudyOrGroupLedJSON,
@@ -423,15 +656,37 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 		&narrativeReportString,
 		&challengesAndProblemEncountered,
 		&prayerRequestString,
+		&report.WorshipServiceAvg,
+		&report.SundaySchoolAvg,
+		&report.PrayerMeetingsAvg,
+		&report.BibleStudiesAvg,
+		&report.MensFellowshipsAvg,
+		&report.WomensFellowshipsAvg,
+		&report.YouthFellowshipsAvg,
+		&report.ChildFellowshipsAvg,
+		&report.OutreachAvg,
+		&report.TrainingOrSeminarsAvg,
+		&report.LeadershipConferencesAvg,
+		&report.LeadershipTrainingAvg,
+		&report.OthersAvg,
+		&report.FamilyDaysAvg,
+		&report.HomeVisitedAvg,
+		&report.BibleStudyOrGroupLedAvg,
+		&report.SermonOrMessagePreachedAvg,
+		&report.PersonNewlyContactedAvg,
+		&report.PersonFollowedUpAvg,
+		&report.PersonLedToChristAvg,
 	)
 
 	// Handle potential errors from scanning
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("report not found")
+			return nil, ErrReportNotFound
 		}
 		return nil, err
 	}
+	report.UserId = int(userId.Int64)
+	report.Finances.SetCurrency(currency)
 
 	// Unmarshal JSONB data into respective fields
 	if err := json.Unmarshal(worshipServiceJSON, &report.WorshipService); err != nil {
@@ -476,9 +731,6 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 	if err := json.Unmarshal(familyDaysJSON, &report.FamilyDays); err != nil {
 		return nil, err
 	}
-	if err := json.Unmarshal(tithesAndOfferingsJSON, &report.TithesAndOfferings); err != nil {
-		return nil, err
-	}
 	if err := json.Unmarshal(homeVisitedJSON, &report.HomeVisited); err != nil {
 		return nil, err
 	}
@@ -515,7 +767,7 @@ func (r *ReportRepositoryImpl) FindById(ctx context.Context, reportId int) (*mod
 	return report, nil
 }
 
-// Save implements BookRepository
+// Save implements ReportRepository
 func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) error {
 	tx, err := r.Db.Begin()
 	if err != nil {
@@ -523,123 +775,229 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 	}
 	defer helper.CommitOrRollback(tx)
 
+	return saveReport(ctx, tx, report)
+}
+
+// Upsert implements ReportRepository. The insert and the replacement are one
+// statement, so concurrent upserts for the same period cannot both create.
+func (r *ReportRepositoryImpl) Upsert(ctx context.Context, report *model.Report) (bool, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	return insertReport(ctx, tx, report, reportUpsertClause)
+}
+
+// SaveAll implements ReportRepository. The transaction is rolled back by
+// hand because CommitOrRollback would commit the reports saved before the
+// failing one.
+func (r *ReportRepositoryImpl) SaveAll(ctx context.Context, reports []*model.Report) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, report := range reports {
+		if err := saveReport(ctx, tx, report); err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				return rollbackErr
+			}
+			return &ReportBatchError{Index: i, Err: err}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// saveReport inserts the report within tx and sets its Id.
+func saveReport(ctx context.Context, tx *sql.Tx, report *model.Report) error {
+	_, err := insertReport(ctx, tx, report, "")
+	return err
+}
+
+// reportUpsertClause replaces the content of the report already filed for the
+// same worker, church and month. The owner, creation time and status are kept,
+// and an approved report is left alone so the insert returns no row.
+const reportUpsertClause = `
+		ON CONFLICT ON CONSTRAINT reports_worker_church_month_key DO UPDATE SET
+			area_id = EXCLUDED.area_id,
+			worship_service = EXCLUDED.worship_service,
+			sunday_school = EXCLUDED.sunday_school,
+			prayer_meetings = EXCLUDED.prayer_meetings,
+			bible_studies = EXCLUDED.bible_studies,
+			mens_fellowships = EXCLUDED.mens_fellowships,
+			womens_fellowships = EXCLUDED.womens_fellowships,
+			youth_fellowships = EXCLUDED.youth_fellowships,
+			child_fellowships = EXCLUDED.child_fellowships,
+			outreach = EXCLUDED.outreach,
+			training_or_seminars = EXCLUDED.training_or_seminars,
+			leadership_conferences = EXCLUDED.leadership_conferences,
+			leadership_training = EXCLUDED.leadership_training,
+			others = EXCLUDED.others,
+			family_days = EXCLUDED.family_days,
+			tithes_minor = EXCLUDED.tithes_minor,
+			offerings_minor = EXCLUDED.offerings_minor,
+			special_gifts_minor = EXCLUDED.special_gifts_minor,
+			currency = EXCLUDED.currency,
+			average_attendance = EXCLUDED.average_attendance,
+			home_visited = EXCLUDED.home_visited,
+			bible_study_or_group_led = EXCLUDED.bible_study_or_group_led,
+			sermon_or_message_preached = EXCLUDED.sermon_or_message_preached,
+			person_newly_contacted = EXCLUDED.person_newly_contacted,
+			person_followed_up = EXCLUDED.person_followed_up,
+			person_led_to_christ = EXCLUDED.person_led_to_christ,
+			names = EXCLUDED.names,
+			narrative_report = EXCLUDED.narrative_report,
+			challenges_and_problem_encountered = EXCLUDED.challenges_and_problem_encountered,
+			prayer_request = EXCLUDED.prayer_request,
+			updated_at = EXCLUDED.updated_at,
+			worship_service_avg = EXCLUDED.worship_service_avg,
+			sunday_school_avg = EXCLUDED.sunday_school_avg,
+			prayer_meetings_avg = EXCLUDED.prayer_meetings_avg,
+			bible_studies_avg = EXCLUDED.bible_studies_avg,
+			mens_fellowships_avg = EXCLUDED.mens_fellowships_avg,
+			womens_fellowships_avg = EXCLUDED.womens_fellowships_avg,
+			youth_fellowships_avg = EXCLUDED.youth_fellowships_avg,
+			child_fellowships_avg = EXCLUDED.child_fellowships_avg,
+			outreach_avg = EXCLUDED.outreach_avg,
+			training_or_seminars_avg = EXCLUDED.training_or_seminars_avg,
+			leadership_conferences_avg = EXCLUDED.leadership_conferences_avg,
+			leadership_training_avg = EXCLUDED.leadership_training_avg,
+			others_avg = EXCLUDED.others_avg,
+			family_days_avg = EXCLUDED.family_days_avg,
+			home_visited_avg = EXCLUDED.home_visited_avg,
+			bible_study_or_group_led_avg = EXCLUDED.bible_study_or_group_led_avg,
+			sermon_or_message_preached_avg = EXCLUDED.sermon_or_message_preached_avg,
+			person_newly_contacted_avg = EXCLUDED.person_newly_contacted_avg,
+			person_followed_up_avg = EXCLUDED.person_followed_up_avg,
+			person_led_to_christ_avg = EXCLUDED.person_led_to_christ_avg
+		WHERE reports.status <> 'approved'
+`
+
+// insertReport inserts the report within tx, followed by onConflict, and sets
+// its Id. It reports whether a new row was created rather than an existing
+// one updated.
+func insertReport(ctx context.Context, tx *sql.Tx, report *model.Report, onConflict string) (bool, error) {
+	currency, err := report.Finances.Currency()
+	if err != nil {
+		return false, err
+	}
+
 	// Marshal arrays to JSONB
 	worshipServiceJSON, err := json.Marshal(report.WorshipService)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	sundaySchoolJSON, err := json.Marshal(report.SundaySchool)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	prayerMeetingsJSON, err := json.Marshal(report.PrayerMeetings)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	bibleStudiesJSON, err := json.Marshal(report.BibleStudies)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	mensFellowshipsJSON, err := json.Marshal(report.MensFellowships)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	womensFellowshipsJSON, err := json.Marshal(report.WomensFellowships)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	youthFellowshipsJSON, err := json.Marshal(report.YouthFellowships)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	childFellowshipsJSON, err := json.Marshal(report.ChildFellowships)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	outreachJSON, err := json.Marshal(report.Outreach)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	trainingOrSeminarsJSON, err := json.Marshal(report.TrainingOrSeminars)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	leadershipConferencesJSON, err := json.Marshal(report.LeadershipConferences)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	leadershipTrainingJSON, err := json.Marshal(report.LeadershipTraining)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	othersJSON, err := json.Marshal(report.Others)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	familyDaysJSON, err := json.Marshal(report.FamilyDays)
 	if err != nil {
-		return err
-	}
-
-	tithesAndOfferingsJSON, err := json.Marshal(report.TithesAndOfferings)
-	if err != nil {
-		return err
+		return false, err
 	}
 
 	homeVisitedJSON, err := json.Marshal(report.HomeVisited)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	bibleStudyOrGroupLedJSON, err := json.Marshal(report.BibleStudyOrGroupLed)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	sermonOrMessagePreachedJSON, err := json.Marshal(report.SermonOrMessagePreached)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	personNewlyContactedJSON, err := json.Marshal(report.PersonNewlyContacted)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	personFollowedUpJSON, err := json.Marshal(report.PersonFollowedUp)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	personLedToChristJSON, err := json.Marshal(report.PersonLedToChrist)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	namesJSON, err := json.Marshal(report.Names)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	rawSQL := `
 		INSERT INTO reports (
+			user_id,
 			month_of,
-			worker_name,
-			area_of_assignment,
-			name_of_church,
+			worker_id,
+			area_id,
+			church_id,
 			worship_service,
 			sunday_school,
 			prayer_meetings,
@@ -654,7 +1012,10 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 			leadership_training,
 			others,
 			family_days,
-			tithes_and_offerings,
+			tithes_minor,
+			offerings_minor,
+			special_gifts_minor,
+			currency,
 			average_attendance,
 			home_visited,
 			bible_study_or_group_led,
@@ -667,15 +1028,42 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 			challenges_and_problem_encountered,
 			prayer_request,
 			created_at,
-			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)
+			updated_at,
+			worship_service_avg,
+			sunday_school_avg,
+			prayer_meetings_avg,
+			bible_studies_avg,
+			mens_fellowships_avg,
+			womens_fellowships_avg,
+			youth_fellowships_avg,
+			child_fellowships_avg,
+			outreach_avg,
+			training_or_seminars_avg,
+			leadership_conferences_avg,
+			leadership_training_avg,
+			others_avg,
+			family_days_avg,
+			home_visited_avg,
+			bible_study_or_group_led_avg,
+			sermon_or_message_preached_avg,
+			person_newly_contacted_avg,
+			person_followed_up_avg,
+			person_led_to_christ_avg,
+			status
+		) VALUES (NULLIF($1, 0), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33,
+			$34, $35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46, $47, $48, $49, $50, $51, $52, $53, $54, $55, $56, $57)
+	` + onConflict + `
+		RETURNING id, (xmax = 0)
 	`
 
-	_, err = tx.ExecContext(ctx, rawSQL,
+	var created bool
+
+	err = tx.QueryRowContext(ctx, rawSQL,
+		report.UserId,
 		report.MonthOf,
-		report.WorkerName,
-		report.AreaOfAssignment,
-		report.NameOfChurch,
+		report.WorkerId,
+		report.AreaId,
+		report.ChurchId,
 		worshipServiceJSON,
 		sundaySchoolJSON,
 		prayerMeetingsJSON,
@@ -690,7 +1078,10 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 		leadershipTrainingJSON,
 		othersJSON,
 		familyDaysJSON,
-		tithesAndOfferingsJSON,
+		report.Finances.Tithes.Minor,
+		report.Finances.Offerings.Minor,
+		report.Finances.SpecialGifts.Minor,
+		currency,
 		report.AverageAttendance,
 		homeVisitedJSON,
 		bibleStudyOrGroupLedJSON,
@@ -704,6 +1095,99 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 		report.PrayerRequest,
 		report.CreatedAt,
 		report.UpdatedAt,
+		report.WorshipServiceAvg,
+		report.SundaySchoolAvg,
+		report.PrayerMeetingsAvg,
+		report.BibleStudiesAvg,
+		report.MensFellowshipsAvg,
+		report.WomensFellowshipsAvg,
+		report.YouthFellowshipsAvg,
+		report.ChildFellowshipsAvg,
+		report.OutreachAvg,
+		report.TrainingOrSeminarsAvg,
+		report.LeadershipConferencesAvg,
+		report.LeadershipTrainingAvg,
+		report.OthersAvg,
+		report.FamilyDaysAvg,
+		report.HomeVisitedAvg,
+		report.BibleStudyOrGroupLedAvg,
+		report.SermonOrMessagePreachedAvg,
+		report.PersonNewlyContactedAvg,
+		report.PersonFollowedUpAvg,
+		report.PersonLedToChristAvg,
+		report.Status,
+	).Scan(&report.Id, &created)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, ErrReportLocked
+		}
+		if isReportDuplicate(err) {
+			return false, ErrReportDuplicate
+		}
+		return false, err
+	}
+
+	return created, nil
+}
+
+// UpdateAverages implements ReportRepository
+func (r *ReportRepositoryImpl) UpdateAverages(ctx context.Context, report *model.Report) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE reports SET
+			worship_service_avg = $1,
+			sunday_school_avg = $2,
+			prayer_meetings_avg = $3,
+			bible_studies_avg = $4,
+			mens_fellowships_avg = $5,
+			womens_fellowships_avg = $6,
+			youth_fellowships_avg = $7,
+			child_fellowships_avg = $8,
+			outreach_avg = $9,
+			training_or_seminars_avg = $10,
+			leadership_conferences_avg = $11,
+			leadership_training_avg = $12,
+			others_avg = $13,
+			family_days_avg = $14,
+			home_visited_avg = $15,
+			bible_study_or_group_led_avg = $16,
+			sermon_or_message_preached_avg = $17,
+			person_newly_contacted_avg = $18,
+			person_followed_up_avg = $19,
+			person_led_to_christ_avg = $20,
+			average_attendance = $21
+		WHERE
+			id = $22
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL,
+		report.WorshipServiceAvg,
+		report.SundaySchoolAvg,
+		report.PrayerMeetingsAvg,
+		report.BibleStudiesAvg,
+		report.MensFellowshipsAvg,
+		report.WomensFellowshipsAvg,
+		report.YouthFellowshipsAvg,
+		report.ChildFellowshipsAvg,
+		report.OutreachAvg,
+		report.TrainingOrSeminarsAvg,
+		report.LeadershipConferencesAvg,
+		report.LeadershipTrainingAvg,
+		report.OthersAvg,
+		report.FamilyDaysAvg,
+		report.HomeVisitedAvg,
+		report.BibleStudyOrGroupLedAvg,
+		report.SermonOrMessagePreachedAvg,
+		report.PersonNewlyContactedAvg,
+		report.PersonFollowedUpAvg,
+		report.PersonLedToChristAvg,
+		report.AverageAttendance,
+		report.Id,
 	)
 	if err != nil {
 		return err
@@ -712,7 +1196,8 @@ func (r *ReportRepositoryImpl) Save(ctx context.Context, report *model.Report) e
 	return nil
 }
 
-// Update implements BookRepository
+// Update implements ReportRepository. Like Delete, it returns ErrReportLocked
+// instead of changing an approved report.
 func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report) error {
 	tx, err := r.Db.Begin()
 	if err != nil {
@@ -720,6 +1205,11 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 	}
 	defer helper.CommitOrRollback(tx)
 
+	currency, err := report.Finances.Currency()
+	if err != nil {
+		return err
+	}
+
 	loc, err := time.LoadLocation("Asia/Manila")
 	if err != nil {
 		helper.ErrorPanic(err)
@@ -730,9 +1220,9 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 	rawSQL := `
 		UPDATE reports SET
 			month_of = $1,
-			worker_name = $2,
-			area_of_assignment = $3,
-			name_of_church = $4,
+			worker_id = $2,
+			area_id = $3,
+			church_id = $4,
 			worship_service = $5,
 			sunday_school = $6,
 			prayer_meetings = $7,
@@ -747,21 +1237,45 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 			leadership_training	= $16,
 			others = $17,
 			family_days = $18,
-			tithes_and_offerings = $19,
-			average_attendance = $20,
-			home_visited = $21,
-			bible_study_or_group_led = $22,
-			sermon_or_message_preached = $23,
-			person_newly_contacted = $24,
-			person_followed_up = $25,
-			person_led_to_christ = $26,
-			names = $27,
-			narrative_report = $28,
-			challenges_and_problem_encountered = $29,
-			prayer_request = $30,
-			updated_at = $31
+			tithes_minor = $19,
+			offerings_minor = $20,
+			special_gifts_minor = $21,
+			currency = $22,
+			average_attendance = $23,
+			home_visited = $24,
+			bible_study_or_group_led = $25,
+			sermon_or_message_preached = $26,
+			person_newly_contacted = $27,
+			person_followed_up = $28,
+			person_led_to_christ = $29,
+			names = $30,
+			narrative_report = $31,
+			challenges_and_problem_encountered = $32,
+			prayer_request = $33,
+			updated_at = $34,
+			worship_service_avg = $36,
+			sunday_school_avg = $37,
+			prayer_meetings_avg = $38,
+			bible_studies_avg = $39,
+			mens_fellowships_avg = $40,
+			womens_fellowships_avg = $41,
+			youth_fellowships_avg = $42,
+			child_fellowships_avg = $43,
+			outreach_avg = $44,
+			training_or_seminars_avg = $45,
+			leadership_conferences_avg = $46,
+			leadership_training_avg = $47,
+			others_avg = $48,
+			family_days_avg = $49,
+			home_visited_avg = $50,
+			bible_study_or_group_led_avg = $51,
+			sermon_or_message_preached_avg = $52,
+			person_newly_contacted_avg = $53,
+			person_followed_up_avg = $54,
+			person_led_to_christ_avg = $55
 		WHERE 
-			id = $32
+			id = $35
+			AND status <> 'approved'
 	`
 
 	// Marshal arrays to JSON
@@ -821,10 +1335,6 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 	if err != nil {
 		return err
 	}
-	tithesAndOfferingsJSON, err := json.Marshal(report.TithesAndOfferings)
-	if err != nil {
-		return err
-	}
 	homeVisitedJSON, err := json.Marshal(report.HomeVisited)
 	if err != nil {
 		return err
@@ -855,11 +1365,11 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 	}
 
 	// Execute the update query
-	_, err = tx.ExecContext(ctx, rawSQL,
+	result, err := tx.ExecContext(ctx, rawSQL,
 		report.MonthOf,
-		report.WorkerName,
-		report.AreaOfAssignment,
-		report.NameOfChurch,
+		report.WorkerId,
+		report.AreaId,
+		report.ChurchId,
 		worshipServiceJSON,
 		sundaySchoolJSON,
 		prayerMeetingsJSON,
@@ -874,7 +1384,10 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 		leadershipTrainingJSON,
 		othersJSON,
 		familyDaysJSON,
-		tithesAndOfferingsJSON,
+		report.Finances.Tithes.Minor,
+		report.Finances.Offerings.Minor,
+		report.Finances.SpecialGifts.Minor,
+		currency,
 		report.AverageAttendance,
 		homeVisitedJSON,
 		bibleStudyOrGroupLedJSON,
@@ -888,10 +1401,146 @@ func (r *ReportRepositoryImpl) Update(ctx context.Context, report *model.Report)
 		report.PrayerRequest,
 		now,
 		report.Id,
+		report.WorshipServiceAvg,
+		report.SundaySchoolAvg,
+		report.PrayerMeetingsAvg,
+		report.BibleStudiesAvg,
+		report.MensFellowshipsAvg,
+		report.WomensFellowshipsAvg,
+		report.YouthFellowshipsAvg,
+		report.ChildFellowshipsAvg,
+		report.OutreachAvg,
+		report.TrainingOrSeminarsAvg,
+		report.LeadershipConferencesAvg,
+		report.LeadershipTrainingAvg,
+		report.OthersAvg,
+		report.FamilyDaysAvg,
+		report.HomeVisitedAvg,
+		report.BibleStudyOrGroupLedAvg,
+		report.SermonOrMessagePreachedAvg,
+		report.PersonNewlyContactedAvg,
+		report.PersonFollowedUpAvg,
+		report.PersonLedToChristAvg,
 	)
 	if err != nil {
+		if isReportDuplicate(err) {
+			return ErrReportDuplicate
+		}
 		return err
 	}
 
-	return nil
+	return lockedUnlessAffected(ctx, tx, result, report.Id)
+}
+
+// ChangeStatus implements ReportRepository. The status is only changed while
+// the report is still in change.From, so two reviewers acting at once cannot
+// both succeed. The transaction is rolled back by hand so a status is never
+// changed without its record.
+func (r *ReportRepositoryImpl) ChangeStatus(ctx context.Context, change *model.ReportStatusChange) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	rollback := func(err error) error {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return rollbackErr
+		}
+		return err
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return rollback(err)
+	}
+
+	rawSQL := `
+		UPDATE reports SET
+			status = $1,
+			updated_at = $4
+		WHERE
+			id = $2
+			AND status = $3
+	`
+
+	result, err := tx.ExecContext(ctx, rawSQL, change.To, change.ReportId, change.From, time.Now().In(loc))
+	if err != nil {
+		return rollback(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return rollback(err)
+	}
+	if affected == 0 {
+		return rollback(ErrReportStatusChanged)
+	}
+
+	rawSQL = `
+		INSERT INTO report_status_changes (report_id, action, from_status, to_status, actor_id, note)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6)
+		RETURNING id, changed_at
+	`
+
+	err = tx.QueryRowContext(ctx, rawSQL, change.ReportId, change.Action, change.From, change.To, change.ActorId, change.Note).
+		Scan(&change.Id, &change.ChangedAt)
+	if err != nil {
+		return rollback(err)
+	}
+
+	return tx.Commit()
+}
+
+// FindStatusChanges implements ReportRepository, oldest change first.
+func (r *ReportRepositoryImpl) FindStatusChanges(ctx context.Context, reportId int) ([]model.ReportStatusChange, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			s.id,
+			s.report_id,
+			s.action,
+			s.from_status,
+			s.to_status,
+			COALESCE(s.actor_id, 0),
+			COALESCE(u.name, ''),
+			s.note,
+			s.changed_at
+		FROM report_status_changes s
+		LEFT JOIN users u ON u.id = s.actor_id
+		WHERE s.report_id = $1
+		ORDER BY s.changed_at, s.id
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL, reportId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	changes := []model.ReportStatusChange{}
+	for result.Next() {
+		var change model.ReportStatusChange
+		err := result.Scan(
+			&change.Id,
+			&change.ReportId,
+			&change.Action,
+			&change.From,
+			&change.To,
+			&change.ActorId,
+			&change.ActorName,
+			&change.Note,
+			&change.ChangedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, result.Err()
 }
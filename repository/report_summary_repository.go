@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"reports/model"
+)
+
+// ReportSummaryRepository aggregates the stored reports in SQL.
+type ReportSummaryRepository interface {
+	Finances(ctx context.Context, filter model.ReportFilter, grouping model.FinanceGrouping) ([]model.FinanceSummary, error)
+	AreaChurches(ctx context.Context, areaId int, period model.Period) ([]model.ChurchSummary, error)
+	Trend(ctx context.Context, filter model.ReportFilter, metric string) ([]model.TrendPoint, error)
+}
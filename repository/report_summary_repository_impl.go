@@ -0,0 +1,346 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reports/helper"
+	"reports/model"
+	"strings"
+)
+
+// financeGroupColumns are the id and name each finance grouping selects.
+var financeGroupColumns = map[model.FinanceGrouping][2]string{
+	model.FinanceByChurch: {"r.church_id", "c.name"},
+	model.FinanceByArea:   {"r.area_id", "a.name"},
+	model.FinanceByMonth:  {"0", "''"},
+}
+
+type ReportSummaryRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func NewReportSummaryRepository(Db *sql.DB) ReportSummaryRepository {
+	return &ReportSummaryRepositoryImpl{Db: Db}
+}
+
+// Finances implements ReportSummaryRepository. The year-to-date sums and the
+// previous month's total need months before filter.MonthFrom, so the reports
+// are read from the start of that year (or the month before, for January) and
+// the earlier months are only dropped from the result.
+func (r *ReportSummaryRepositoryImpl) Finances(ctx context.Context, filter model.ReportFilter, grouping model.FinanceGrouping) ([]model.FinanceSummary, error) {
+	columns, ok := financeGroupColumns[grouping]
+	if !ok {
+		return nil, fmt.Errorf("unknown finance grouping %q", grouping)
+	}
+
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	monthFrom := filter.MonthFrom
+	if !monthFrom.IsZero() {
+		filter.MonthFrom = model.NewPeriod(monthFrom.Year, 1)
+		if previous := monthFrom.AddMonths(-1); previous.Before(filter.MonthFrom) {
+			filter.MonthFrom = previous
+		}
+	}
+
+	where, args := reportWhereClause(filter)
+	groupBy := "r.month_of, r.currency"
+	if grouping != model.FinanceByMonth {
+		groupBy = columns[0] + ", " + columns[1] + ", " + groupBy
+	}
+
+	rawSQL := fmt.Sprintf(`
+		WITH monthly AS (
+			SELECT
+				%[1]s AS group_id,
+				%[2]s AS group_name,
+				r.month_of,
+				r.currency,
+				count(*) AS reports,
+				SUM(r.tithes_minor)::BIGINT AS tithes,
+				SUM(r.offerings_minor)::BIGINT AS offerings,
+				SUM(r.special_gifts_minor)::BIGINT AS special_gifts,
+				SUM(r.tithes_minor + r.offerings_minor + r.special_gifts_minor)::BIGINT AS total
+			FROM reports r
+			JOIN workers w ON w.id = r.worker_id
+			JOIN churches c ON c.id = r.church_id
+			JOIN areas a ON a.id = r.area_id
+			%[3]s
+			GROUP BY %[4]s
+		), summarized AS (
+			SELECT
+				monthly.*,
+				CASE WHEN LAG(month_of) OVER previous = month_of - INTERVAL '1 month'
+					THEN LAG(total) OVER previous
+				END AS previous_total,
+				SUM(total) OVER (
+					PARTITION BY group_id, currency, date_trunc('year', month_of)
+					ORDER BY month_of
+				)::BIGINT AS year_to_date
+			FROM monthly
+			WINDOW previous AS (PARTITION BY group_id, currency ORDER BY month_of)
+		)
+		SELECT group_id, group_name, month_of, currency, reports, tithes, offerings, special_gifts, total, previous_total, year_to_date
+		FROM summarized
+	`, columns[0], columns[1], where, groupBy)
+
+	if !monthFrom.IsZero() {
+		args = append(args, monthFrom)
+		rawSQL += fmt.Sprintf("WHERE month_of >= $%d\n", len(args))
+	}
+	rawSQL += "ORDER BY group_name, group_id, month_of, currency"
+
+	rows, err := tx.QueryContext(ctx, rawSQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []model.FinanceSummary
+	for rows.Next() {
+		var (
+			summary       model.FinanceSummary
+			currency      string
+			tithes        int64
+			offerings     int64
+			specialGifts  int64
+			total         int64
+			previousTotal sql.NullInt64
+			yearToDate    int64
+		)
+
+		if err := rows.Scan(
+			&summary.GroupId,
+			&summary.GroupName,
+			&summary.MonthOf,
+			&currency,
+			&summary.Reports,
+			&tithes,
+			&offerings,
+			&specialGifts,
+			&total,
+			&previousTotal,
+			&yearToDate,
+		); err != nil {
+			return nil, err
+		}
+
+		summary.Tithes = model.NewMoney(tithes, currency)
+		summary.Offerings = model.NewMoney(offerings, currency)
+		summary.SpecialGifts = model.NewMoney(specialGifts, currency)
+		summary.Total = model.NewMoney(total, currency)
+		summary.YearToDate = model.NewMoney(yearToDate, currency)
+		if previousTotal.Valid {
+			change := model.NewMoney(total-previousTotal.Int64, currency)
+			summary.Change = &change
+			summary.ChangePercent = model.PercentChange(float64(previousTotal.Int64), float64(total))
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// activitySummaryColumns selects the total, sessions held and summed average
+// of every weekly activity over the grouped reports r.
+func activitySummaryColumns() string {
+	columns := make([]string, 0, len(model.ActivityKeys()))
+	for _, key := range model.ActivityKeys() {
+		entries := weeklyEntryElements("r." + key)
+		columns = append(columns, fmt.Sprintf(`
+			COALESCE(SUM((SELECT SUM((e->>'count')::INT) FROM %[1]s e)), 0)::BIGINT,
+			COALESCE(SUM((SELECT count(e->>'count') FROM %[1]s e)), 0)::BIGINT,
+			COALESCE(SUM(r.%[2]s_avg), 0)`, entries, key))
+	}
+	return strings.Join(columns, ",")
+}
+
+// weeklyEntryElements expands a weekly entries column into one row per entry.
+// An omitted activity is stored as JSONB null, which jsonb_array_elements
+// rejects, so anything but an array counts as no entries.
+func weeklyEntryElements(column string) string {
+	return fmt.Sprintf(`jsonb_array_elements(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE '[]' END)`, column)
+}
+
+// AreaChurches implements ReportSummaryRepository. It returns every church
+// of the area and its sub-areas together with the churches the area's reports
+// for the month were filed on, each with its reports summed.
+func (r *ReportSummaryRepositoryImpl) AreaChurches(ctx context.Context, areaId int, period model.Period) ([]model.ChurchSummary, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := fmt.Sprintf(`
+		WITH subtree AS (
+			SELECT id FROM area_subtree($1)
+		)
+		SELECT
+			c.id,
+			c.name,
+			(c.area_id IN (SELECT id FROM subtree) AND c.created_at < $3) AS expected,
+			count(r.id),
+			COALESCE(SUM(r.average_attendance), 0),%s
+		FROM churches c
+		LEFT JOIN reports r
+			ON r.church_id = c.id
+			AND r.month_of = $2
+			AND r.area_id IN (SELECT id FROM subtree)
+		WHERE c.area_id IN (SELECT id FROM subtree) OR r.id IS NOT NULL
+		GROUP BY c.id
+		ORDER BY c.name, c.id
+	`, activitySummaryColumns())
+
+	rows, err := tx.QueryContext(ctx, rawSQL, areaId, period, period.AddMonths(1).Start())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := model.ActivityKeys()
+	var churches []model.ChurchSummary
+	for rows.Next() {
+		church := model.ChurchSummary{Activities: make(map[string]model.ActivitySummary, len(keys))}
+		activities := make([]model.ActivitySummary, len(keys))
+
+		dest := []interface{}{
+			&church.ChurchId,
+			&church.ChurchName,
+			&church.Expected,
+			&church.Reports,
+			&church.AverageAttendance,
+		}
+		for i := range activities {
+			dest = append(dest, &activities[i].Total, &activities[i].Sessions, &activities[i].Average)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		for i, key := range keys {
+			church.Activities[key] = activities[i]
+		}
+		churches = append(churches, church)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return churches, nil
+}
+
+// trendMetricColumn returns the stored column a trend metric is read from.
+func trendMetricColumn(metric string) (string, bool) {
+	if metric == model.TrendMetricAverageAttendance {
+		return "r.average_attendance", true
+	}
+	if model.IsActivityKey(metric) {
+		return "r." + metric + "_avg", true
+	}
+	return "", false
+}
+
+// Trend implements ReportSummaryRepository. It returns one point for every
+// month from filter.MonthFrom to filter.MonthTo, both of which must be set,
+// including months without reports. Twelve earlier months are read so the
+// first points have a year-over-year comparison.
+func (r *ReportSummaryRepositoryImpl) Trend(ctx context.Context, filter model.ReportFilter, metric string) ([]model.TrendPoint, error) {
+	column, ok := trendMetricColumn(metric)
+	if !ok {
+		return nil, fmt.Errorf("unknown trend metric %q", metric)
+	}
+
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	monthFrom := filter.MonthFrom
+	filter.MonthFrom = monthFrom.AddMonths(-12)
+
+	where, args := reportWhereClause(filter)
+	args = append(args, filter.MonthFrom, filter.MonthTo, monthFrom)
+	seriesFrom, seriesTo, pointsFrom := len(args)-2, len(args)-1, len(args)
+
+	rawSQL := fmt.Sprintf(`
+		WITH months AS (
+			SELECT generate_series($%[3]d::DATE, $%[4]d::DATE, INTERVAL '1 month')::DATE AS month_of
+		), monthly AS (
+			SELECT
+				r.month_of,
+				count(*) AS reports,
+				SUM(%[1]s)::FLOAT8 AS value
+			FROM reports r
+			JOIN workers w ON w.id = r.worker_id
+			JOIN churches c ON c.id = r.church_id
+			JOIN areas a ON a.id = r.area_id
+			%[2]s
+			GROUP BY r.month_of
+		), series AS (
+			SELECT
+				months.month_of,
+				COALESCE(monthly.reports, 0) AS reports,
+				monthly.value,
+				LAG(monthly.value) OVER (ORDER BY months.month_of) AS previous_month,
+				LAG(monthly.value, 12) OVER (ORDER BY months.month_of) AS previous_year
+			FROM months
+			LEFT JOIN monthly ON monthly.month_of = months.month_of
+		)
+		SELECT month_of, reports, value, previous_month, previous_year
+		FROM series
+		WHERE month_of >= $%[5]d
+		ORDER BY month_of
+	`, column, where, seriesFrom, seriesTo, pointsFrom)
+
+	rows, err := tx.QueryContext(ctx, rawSQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var points []model.TrendPoint
+	for rows.Next() {
+		var (
+			point         model.TrendPoint
+			value         sql.NullFloat64
+			previousMonth sql.NullFloat64
+			previousYear  sql.NullFloat64
+		)
+
+		if err := rows.Scan(&point.MonthOf, &point.Reports, &value, &previousMonth, &previousYear); err != nil {
+			return nil, err
+		}
+
+		point.Value = nullFloat(value)
+		point.PreviousMonth = nullFloat(previousMonth)
+		point.PreviousYear = nullFloat(previousYear)
+		points = append(points, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return points, nil
+}
+
+func nullFloat(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
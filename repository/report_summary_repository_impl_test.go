@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"encoding/json"
+	"reports/model"
+	"strings"
+	"testing"
+)
+
+func TestActivitySummaryColumnsGuardNonArrays(t *testing.T) {
+	// A report that omits an activity stores its entries as JSONB null.
+	var omitted model.Report
+	stored, err := json.Marshal(omitted.SundaySchool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(stored) != "null" {
+		t.Fatalf("an omitted activity is stored as %s, want null", stored)
+	}
+
+	columns := activitySummaryColumns()
+	for _, key := range model.ActivityKeys() {
+		guarded := weeklyEntryElements("r." + key)
+		if got := strings.Count(columns, guarded); got != 2 {
+			t.Errorf("%s is expanded through the array guard %d times, want 2", key, got)
+		}
+		if strings.Contains(columns, "jsonb_array_elements(r."+key+")") || strings.Contains(columns, "jsonb_array_elements(COALESCE(r."+key+",") {
+			t.Errorf("%s reaches jsonb_array_elements without the array guard", key)
+		}
+	}
+
+	want := `jsonb_array_elements(CASE WHEN jsonb_typeof(r.outreach) = 'array' THEN r.outreach ELSE '[]' END)`
+	if got := weeklyEntryElements("r.outreach"); got != want {
+		t.Errorf("weeklyEntryElements() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"reports/model"
+)
+
+type UserRepository interface {
+	Save(ctx context.Context, user *model.User) error
+	Update(ctx context.Context, user *model.User) error
+	UpdatePassword(ctx context.Context, userId int, hashedPassword string) error
+	Delete(ctx context.Context, userId int) error
+	FindById(ctx context.Context, userId int) (*model.User, error)
+	FindByUsername(ctx context.Context, username string) (*model.User, error)
+	FindAll(ctx context.Context) ([]model.User, error)
+}
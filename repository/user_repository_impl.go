@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reports/helper"
+	"reports/model"
+	"time"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+type UserRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func NewUserRepository(Db *sql.DB) UserRepository {
+	return &UserRepositoryImpl{Db: Db}
+}
+
+// Delete implements UserRepository
+func (r *UserRepositoryImpl) Delete(ctx context.Context, userId int) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		DELETE FROM users
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL, userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindAll implements UserRepository
+func (r *UserRepositoryImpl) FindAll(ctx context.Context) ([]model.User, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			username,
+			name,
+			email,
+			password,
+			role,
+			area_id,
+			created_at,
+			updated_at
+		FROM users
+		ORDER BY username
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var users []model.User
+
+	for result.Next() {
+		var user model.User
+		var (
+			email  sql.NullString
+			areaId sql.NullInt64
+		)
+
+		err := result.Scan(
+			&user.Id,
+			&user.Username,
+			&user.Name,
+			&email,
+			&user.Password,
+			&user.Role,
+			&areaId,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		user.Email = email.String
+		user.AreaId = int(areaId.Int64)
+
+		users = append(users, user)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// FindById implements UserRepository
+func (r *UserRepositoryImpl) FindById(ctx context.Context, userId int) (*model.User, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			username,
+			name,
+			email,
+			password,
+			role,
+			area_id,
+			created_at,
+			updated_at
+		FROM users
+		WHERE
+			id = $1
+	`
+
+	user := &model.User{}
+	var (
+		email  sql.NullString
+		areaId sql.NullInt64
+	)
+
+	err = tx.QueryRowContext(ctx, rawSQL, userId).Scan(
+		&user.Id,
+		&user.Username,
+		&user.Name,
+		&email,
+		&user.Password,
+		&user.Role,
+		&areaId,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	user.Email = email.String
+	user.AreaId = int(areaId.Int64)
+
+	return user, nil
+}
+
+// FindByUsername implements UserRepository
+func (r *UserRepositoryImpl) FindByUsername(ctx context.Context, username string) (*model.User, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			username,
+			name,
+			email,
+			password,
+			role,
+			area_id,
+			created_at,
+			updated_at
+		FROM users
+		WHERE
+			username = $1
+	`
+
+	user := &model.User{}
+	var (
+		email  sql.NullString
+		areaId sql.NullInt64
+	)
+
+	err = tx.QueryRowContext(ctx, rawSQL, username).Scan(
+		&user.Id,
+		&user.Username,
+		&user.Name,
+		&email,
+		&user.Password,
+		&user.Role,
+		&areaId,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	user.Email = email.String
+	user.AreaId = int(areaId.Int64)
+
+	return user, nil
+}
+
+// Save implements UserRepository
+func (r *UserRepositoryImpl) Save(ctx context.Context, user *model.User) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		INSERT INTO users (
+			username,
+			name,
+			email,
+			password,
+			role,
+			area_id,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, 0), $7, $8)
+		RETURNING id
+	`
+
+	err = tx.QueryRowContext(ctx, rawSQL,
+		user.Username,
+		user.Name,
+		user.Email,
+		user.Password,
+		user.Role,
+		user.AreaId,
+		user.CreatedAt,
+		user.UpdatedAt,
+	).Scan(&user.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update implements UserRepository
+func (r *UserRepositoryImpl) Update(ctx context.Context, user *model.User) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE users SET
+			name = $1,
+			email = NULLIF($2, ''),
+			role = $3,
+			area_id = NULLIF($4, 0),
+			updated_at = $5
+		WHERE
+			id = $6
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL,
+		user.Name,
+		user.Email,
+		user.Role,
+		user.AreaId,
+		time.Now(),
+		user.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePassword implements UserRepository
+func (r *UserRepositoryImpl) UpdatePassword(ctx context.Context, userId int, hashedPassword string) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE users SET
+			password = $1,
+			updated_at = $2
+		WHERE
+			id = $3
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL, hashedPassword, time.Now(), userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"reports/model"
+)
+
+type WorkerRepository interface {
+	Save(ctx context.Context, worker *model.Worker) error
+	Update(ctx context.Context, worker *model.Worker) error
+	Delete(ctx context.Context, workerId int) error
+	FindById(ctx context.Context, workerId int) (*model.Worker, error)
+	FindAll(ctx context.Context, filter model.WorkerFilter) ([]model.Worker, error)
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reports/helper"
+	"reports/model"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrWorkerNotFound = errors.New("worker not found")
+	ErrWorkerInUse    = errors.New("worker still has reports")
+)
+
+type WorkerRepositoryImpl struct {
+	Db *sql.DB
+}
+
+func NewWorkerRepository(Db *sql.DB) WorkerRepository {
+	return &WorkerRepositoryImpl{Db: Db}
+}
+
+// Delete implements WorkerRepository
+func (r *WorkerRepositoryImpl) Delete(ctx context.Context, workerId int) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		DELETE FROM workers
+		WHERE id = $1
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL, workerId)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return ErrWorkerInUse
+		}
+		return err
+	}
+
+	return nil
+}
+
+// FindAll implements WorkerRepository
+func (r *WorkerRepositoryImpl) FindAll(ctx context.Context, filter model.WorkerFilter) ([]model.Worker, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			contact_number,
+			email,
+			status,
+			user_id,
+			area_id,
+			created_at,
+			updated_at
+		FROM workers
+		WHERE
+			$1 = 0 OR area_id IN (SELECT id FROM area_subtree($1))
+		ORDER BY name
+	`
+
+	result, err := tx.QueryContext(ctx, rawSQL, filter.AreaId)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var workers []model.Worker
+
+	for result.Next() {
+		var worker model.Worker
+		var (
+			contactNumber sql.NullString
+			email         sql.NullString
+			userId        sql.NullInt64
+			areaId        sql.NullInt64
+		)
+
+		err := result.Scan(
+			&worker.Id,
+			&worker.Name,
+			&contactNumber,
+			&email,
+			&worker.Status,
+			&userId,
+			&areaId,
+			&worker.CreatedAt,
+			&worker.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		worker.ContactNumber = contactNumber.String
+		worker.Email = email.String
+		worker.UserId = int(userId.Int64)
+		worker.AreaId = int(areaId.Int64)
+
+		workers = append(workers, worker)
+	}
+
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return workers, nil
+}
+
+// FindById implements WorkerRepository
+func (r *WorkerRepositoryImpl) FindById(ctx context.Context, workerId int) (*model.Worker, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		SELECT
+			id,
+			name,
+			contact_number,
+			email,
+			status,
+			user_id,
+			area_id,
+			created_at,
+			updated_at
+		FROM workers
+		WHERE
+			id = $1
+	`
+
+	worker := &model.Worker{}
+	var (
+		contactNumber sql.NullString
+		email         sql.NullString
+		userId        sql.NullInt64
+		areaId        sql.NullInt64
+	)
+
+	err = tx.QueryRowContext(ctx, rawSQL, workerId).Scan(
+		&worker.Id,
+		&worker.Name,
+		&contactNumber,
+		&email,
+		&worker.Status,
+		&userId,
+		&areaId,
+		&worker.CreatedAt,
+		&worker.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWorkerNotFound
+		}
+		return nil, err
+	}
+	worker.ContactNumber = contactNumber.String
+	worker.Email = email.String
+	worker.UserId = int(userId.Int64)
+	worker.AreaId = int(areaId.Int64)
+
+	return worker, nil
+}
+
+// Save implements WorkerRepository
+func (r *WorkerRepositoryImpl) Save(ctx context.Context, worker *model.Worker) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		INSERT INTO workers (
+			name,
+			contact_number,
+			email,
+			status,
+			user_id,
+			area_id,
+			created_at,
+			updated_at
+		) VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, NULLIF($5, 0), NULLIF($6, 0), $7, $8)
+		RETURNING id
+	`
+
+	err = tx.QueryRowContext(ctx, rawSQL,
+		worker.Name,
+		worker.ContactNumber,
+		worker.Email,
+		worker.Status,
+		worker.UserId,
+		worker.AreaId,
+		worker.CreatedAt,
+		worker.UpdatedAt,
+	).Scan(&worker.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Update implements WorkerRepository
+func (r *WorkerRepositoryImpl) Update(ctx context.Context, worker *model.Worker) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer helper.CommitOrRollback(tx)
+
+	rawSQL := `
+		UPDATE workers SET
+			name = $1,
+			contact_number = NULLIF($2, ''),
+			email = NULLIF($3, ''),
+			status = $4,
+			user_id = NULLIF($5, 0),
+			area_id = NULLIF($6, 0),
+			updated_at = $7
+		WHERE
+			id = $8
+	`
+
+	_, err = tx.ExecContext(ctx, rawSQL,
+		worker.Name,
+		worker.ContactNumber,
+		worker.Email,
+		worker.Status,
+		worker.UserId,
+		worker.AreaId,
+		time.Now(),
+		worker.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,25 +3,107 @@ package router
 import (
 	"net/http"
 	"reports/controller"
+	"reports/helper"
+	"reports/middleware"
+	"reports/model"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 )
 
-func NewRouter(reportController *controller.ReportController) *gin.Engine {
+func NewRouter(authMiddleware gin.HandlerFunc, authController *controller.AuthController, userController *controller.UserController, workerController *controller.WorkerController, churchController *controller.ChurchController, areaController *controller.AreaController, reportController *controller.ReportController, reportSummaryController *controller.ReportSummaryController) *gin.Engine {
+	if validate, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		helper.RegisterJSONFieldNames(validate)
+	}
+
 	service := gin.Default()
 
 	service.GET("/", func(ctx *gin.Context) {
 		ctx.JSON(http.StatusOK, "Welcome Home!")
 	})
 
+	// Auth Group
+	authRouter := service.Group("/auth")
+
+	authRouter.POST("/login", authController.Login)
+	authRouter.GET("/logout", authController.Logout)
+
 	// Api Group
-	router := service.Group("/api")
+	router := service.Group("/api", authMiddleware)
 
 	router.GET("", reportController.FindAll)
 	router.POST("", reportController.Create)
 	router.GET("/:reportId", reportController.FindById)
 	router.PUT("/:reportId", reportController.Update)
+	router.PATCH("/:reportId", reportController.Patch)
 	router.DELETE("/:reportId", reportController.Delete)
+	router.GET("/:reportId/history", reportController.History)
+	router.POST("/:reportId/submit", reportController.Submit)
+	router.POST("/:reportId/review", reportController.Review)
+	router.POST("/:reportId/return", reportController.Return)
+	router.POST("/:reportId/approve", reportController.Approve)
+	router.POST("/:reportId/reopen", reportController.Reopen)
+
+	// Export Group
+	exportRouter := router.Group("/exports")
+
+	exportRouter.GET("/reports.csv", reportController.ExportCSV)
+	exportRouter.GET("/reports.xlsx", reportController.ExportXLSX)
+
+	// Summary Group
+	summaryRouter := router.Group("/summaries")
+
+	summaryRouter.GET("/finances", reportSummaryController.Finances)
+	summaryRouter.GET("/areas/:areaId", reportSummaryController.Area)
+	summaryRouter.GET("/trends", reportSummaryController.Trend)
+
+	// User Group
+	userRouter := router.Group("/users")
+
+	userRouter.GET("/me", userController.Me)
+	userRouter.PUT("/me/password", userController.ChangePassword)
+
+	adminOnly := middleware.RequireRole(model.RoleAdmin)
+
+	userRouter.GET("", adminOnly, userController.FindAll)
+	userRouter.POST("", adminOnly, userController.Create)
+	userRouter.GET("/:userId", adminOnly, userController.FindById)
+	userRouter.PUT("/:userId", adminOnly, userController.Update)
+	userRouter.PUT("/:userId/password", adminOnly, userController.ResetPassword)
+	userRouter.DELETE("/:userId", adminOnly, userController.Delete)
+
+	// Import Group
+	importRouter := router.Group("/imports", adminOnly)
+
+	importRouter.POST("/reports", reportController.Import)
+
+	// Worker Group
+	workerRouter := router.Group("/workers")
+
+	workerRouter.GET("", workerController.FindAll)
+	workerRouter.GET("/:workerId", workerController.FindById)
+	workerRouter.POST("", adminOnly, workerController.Create)
+	workerRouter.PUT("/:workerId", adminOnly, workerController.Update)
+	workerRouter.DELETE("/:workerId", adminOnly, workerController.Delete)
+
+	// Church Group
+	churchRouter := router.Group("/churches")
+
+	churchRouter.GET("", churchController.FindAll)
+	churchRouter.GET("/:churchId", churchController.FindById)
+	churchRouter.POST("", adminOnly, churchController.Create)
+	churchRouter.PUT("/:churchId", adminOnly, churchController.Update)
+	churchRouter.DELETE("/:churchId", adminOnly, churchController.Delete)
+
+	// Area Group
+	areaRouter := router.Group("/areas")
+
+	areaRouter.GET("", areaController.FindAll)
+	areaRouter.GET("/:areaId", areaController.FindById)
+	areaRouter.POST("", adminOnly, areaController.Create)
+	areaRouter.PUT("/:areaId", adminOnly, areaController.Update)
+	areaRouter.DELETE("/:areaId", adminOnly, areaController.Delete)
 
 	return service
 }
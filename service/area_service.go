@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type AreaService interface {
+	Create(ctx context.Context, request *request.AreaCreateRequest) (response.AreaResponse, error)
+	Update(ctx context.Context, request *request.AreaUpdateRequest) error
+	Delete(ctx context.Context, areaId int) error
+	FindById(ctx context.Context, areaId int) (response.AreaResponse, error)
+	FindAll(ctx context.Context) ([]response.AreaResponse, error)
+}
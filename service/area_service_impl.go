@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+var (
+	ErrInvalidAreaParent = errors.New("regions have no parent, districts belong to a region and areas belong to a district")
+	ErrAreaHasChildren   = errors.New("cannot change the level of an area that has sub-areas")
+)
+
+type AreaServiceImpl struct {
+	areaRepository repository.AreaRepository
+}
+
+func NewAreaServiceImpl(areaRepository repository.AreaRepository) AreaService {
+	return &AreaServiceImpl{areaRepository: areaRepository}
+}
+
+func (a *AreaServiceImpl) Create(ctx context.Context, request *request.AreaCreateRequest) (response.AreaResponse, error) {
+	level := model.AreaLevel(request.Level)
+	if err := a.validateParent(ctx, level, request.ParentId); err != nil {
+		return response.AreaResponse{}, err
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return response.AreaResponse{}, err
+	}
+
+	now := time.Now().In(loc)
+
+	area := model.Area{
+		Name:      request.Name,
+		Level:     level,
+		ParentId:  request.ParentId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err = a.areaRepository.Save(ctx, &area)
+	if err != nil {
+		return response.AreaResponse{}, fmt.Errorf("failed to save area: %w", err)
+	}
+
+	return toAreaResponse(&area), nil
+}
+
+func (a *AreaServiceImpl) Update(ctx context.Context, request *request.AreaUpdateRequest) error {
+	area, err := a.areaRepository.FindById(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+
+	level := model.AreaLevel(request.Level)
+	if err := a.validateParent(ctx, level, request.ParentId); err != nil {
+		return err
+	}
+
+	if level != area.Level {
+		subtree, err := a.areaRepository.FindSubtreeIds(ctx, area.Id)
+		if err != nil {
+			return err
+		}
+		if len(subtree) > 1 {
+			return ErrAreaHasChildren
+		}
+	}
+
+	area.Name = request.Name
+	area.Level = level
+	area.ParentId = request.ParentId
+
+	return a.areaRepository.Update(ctx, area)
+}
+
+func (a *AreaServiceImpl) Delete(ctx context.Context, areaId int) error {
+	area, err := a.areaRepository.FindById(ctx, areaId)
+	if err != nil {
+		return err
+	}
+
+	return a.areaRepository.Delete(ctx, area.Id)
+}
+
+func (a *AreaServiceImpl) FindById(ctx context.Context, areaId int) (response.AreaResponse, error) {
+	area, err := a.areaRepository.FindById(ctx, areaId)
+	if err != nil {
+		return response.AreaResponse{}, err
+	}
+
+	return toAreaResponse(area), nil
+}
+
+func (a *AreaServiceImpl) FindAll(ctx context.Context) ([]response.AreaResponse, error) {
+	areas, err := a.areaRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var areaResp []response.AreaResponse
+	for i := range areas {
+		areaResp = append(areaResp, toAreaResponse(&areas[i]))
+	}
+
+	return areaResp, nil
+}
+
+// validateParent checks that the parent sits exactly one level above the area.
+// Because levels strictly descend, this also rules out cycles.
+func (a *AreaServiceImpl) validateParent(ctx context.Context, level model.AreaLevel, parentId int) error {
+	expected := level.ParentLevel()
+
+	if parentId == 0 {
+		if expected != "" {
+			return ErrInvalidAreaParent
+		}
+		return nil
+	}
+
+	parent, err := a.areaRepository.FindById(ctx, parentId)
+	if errors.Is(err, repository.ErrAreaNotFound) {
+		return ErrInvalidAreaParent
+	}
+	if err != nil {
+		return err
+	}
+
+	if parent.Level != expected {
+		return ErrInvalidAreaParent
+	}
+
+	return nil
+}
+
+func toAreaResponse(area *model.Area) response.AreaResponse {
+	return response.AreaResponse{
+		Id:        area.Id,
+		Name:      area.Name,
+		Level:     string(area.Level),
+		ParentId:  area.ParentId,
+		CreatedAt: area.CreatedAt,
+		UpdatedAt: area.UpdatedAt,
+	}
+}
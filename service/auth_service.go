@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type AuthService interface {
+	Login(ctx context.Context, request *request.LoginRequest) (response.LoginResponse, error)
+}
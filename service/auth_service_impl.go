@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reports/config"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/helper"
+	"reports/repository"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+type AuthServiceImpl struct {
+	userRepository repository.UserRepository
+	config         *config.Config
+}
+
+func NewAuthServiceImpl(userRepository repository.UserRepository, config *config.Config) AuthService {
+	return &AuthServiceImpl{userRepository: userRepository, config: config}
+}
+
+func (a *AuthServiceImpl) Login(ctx context.Context, request *request.LoginRequest) (response.LoginResponse, error) {
+	user, err := a.userRepository.FindByUsername(ctx, request.Username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return response.LoginResponse{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return response.LoginResponse{}, err
+	}
+
+	if err := helper.VerifyPassword(user.Password, request.Password); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return response.LoginResponse{}, ErrInvalidCredentials
+		}
+		return response.LoginResponse{}, err
+	}
+
+	token, err := helper.GenerateToken(a.config.TokenExpiresIn, user.Id, a.config.TokenSecret)
+	if err != nil {
+		return response.LoginResponse{}, err
+	}
+
+	return response.LoginResponse{TokenType: "Bearer", Token: token}, nil
+}
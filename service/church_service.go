@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type ChurchService interface {
+	Create(ctx context.Context, request *request.ChurchCreateRequest) (response.ChurchResponse, error)
+	Update(ctx context.Context, request *request.ChurchUpdateRequest) error
+	Delete(ctx context.Context, churchId int) error
+	FindById(ctx context.Context, churchId int) (response.ChurchResponse, error)
+	FindAll(ctx context.Context, request *request.ChurchListRequest) ([]response.ChurchResponse, error)
+}
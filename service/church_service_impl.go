@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+type ChurchServiceImpl struct {
+	churchRepository repository.ChurchRepository
+	areaRepository   repository.AreaRepository
+}
+
+func NewChurchServiceImpl(churchRepository repository.ChurchRepository, areaRepository repository.AreaRepository) ChurchService {
+	return &ChurchServiceImpl{churchRepository: churchRepository, areaRepository: areaRepository}
+}
+
+func (c *ChurchServiceImpl) Create(ctx context.Context, request *request.ChurchCreateRequest) (response.ChurchResponse, error) {
+	dateOrganized, err := parseDateOrganized(request.DateOrganized)
+	if err != nil {
+		return response.ChurchResponse{}, err
+	}
+
+	if request.AreaId != 0 {
+		if _, err := c.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return response.ChurchResponse{}, err
+		}
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return response.ChurchResponse{}, err
+	}
+
+	now := time.Now().In(loc)
+
+	status := model.ChurchStatus(request.Status)
+	if status == "" {
+		status = model.ChurchStatusPreachingPoint
+	}
+
+	church := model.Church{
+		Name:          request.Name,
+		Address:       request.Address,
+		Barangay:      request.Barangay,
+		Municipality:  request.Municipality,
+		Province:      request.Province,
+		DateOrganized: dateOrganized,
+		Status:        status,
+		AreaId:        request.AreaId,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err = c.churchRepository.Save(ctx, &church)
+	if err != nil {
+		return response.ChurchResponse{}, fmt.Errorf("failed to save church: %w", err)
+	}
+
+	return toChurchResponse(&church), nil
+}
+
+func (c *ChurchServiceImpl) Update(ctx context.Context, request *request.ChurchUpdateRequest) error {
+	church, err := c.churchRepository.FindById(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+
+	dateOrganized, err := parseDateOrganized(request.DateOrganized)
+	if err != nil {
+		return err
+	}
+
+	if request.AreaId != 0 {
+		if _, err := c.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return err
+		}
+	}
+
+	church.Name = request.Name
+	church.Address = request.Address
+	church.Barangay = request.Barangay
+	church.Municipality = request.Municipality
+	church.Province = request.Province
+	church.DateOrganized = dateOrganized
+	church.Status = model.ChurchStatus(request.Status)
+	church.AreaId = request.AreaId
+
+	return c.churchRepository.Update(ctx, church)
+}
+
+func (c *ChurchServiceImpl) Delete(ctx context.Context, churchId int) error {
+	church, err := c.churchRepository.FindById(ctx, churchId)
+	if err != nil {
+		return err
+	}
+
+	return c.churchRepository.Delete(ctx, church.Id)
+}
+
+func (c *ChurchServiceImpl) FindById(ctx context.Context, churchId int) (response.ChurchResponse, error) {
+	church, err := c.churchRepository.FindById(ctx, churchId)
+	if err != nil {
+		return response.ChurchResponse{}, err
+	}
+
+	return toChurchResponse(church), nil
+}
+
+func (c *ChurchServiceImpl) FindAll(ctx context.Context, request *request.ChurchListRequest) ([]response.ChurchResponse, error) {
+	churches, err := c.churchRepository.FindAll(ctx, model.ChurchFilter{AreaId: request.AreaId})
+	if err != nil {
+		return nil, err
+	}
+
+	var churchResp []response.ChurchResponse
+	for i := range churches {
+		churchResp = append(churchResp, toChurchResponse(&churches[i]))
+	}
+
+	return churchResp, nil
+}
+
+func parseDateOrganized(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date_organized: %w", err)
+	}
+
+	return &date, nil
+}
+
+func toChurchResponse(church *model.Church) response.ChurchResponse {
+	churchResp := response.ChurchResponse{
+		Id:           church.Id,
+		Name:         church.Name,
+		Address:      church.Address,
+		Barangay:     church.Barangay,
+		Municipality: church.Municipality,
+		Province:     church.Province,
+		Status:       string(church.Status),
+		AreaId:       church.AreaId,
+		CreatedAt:    church.CreatedAt,
+		UpdatedAt:    church.UpdatedAt,
+	}
+
+	if church.DateOrganized != nil {
+		churchResp.DateOrganized = church.DateOrganized.Format("2006-01-02")
+	}
+
+	return churchResp
+}
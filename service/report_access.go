@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reports/helper"
+	"reports/model"
+	"reports/repository"
+)
+
+var (
+	ErrUnauthenticated = errors.New("no authenticated user in context")
+	ErrForbidden       = errors.New("you do not have permission to perform this action")
+)
+
+func currentUser(ctx context.Context) (*model.User, error) {
+	user, ok := helper.CurrentUser(ctx)
+	if !ok || user == nil {
+		return nil, ErrUnauthenticated
+	}
+	return user, nil
+}
+
+// reportAccess holds the acting user together with the areas they supervise,
+// which for an area supervisor is their assigned area and every area below it.
+type reportAccess struct {
+	user          *model.User
+	supervisedIds map[int]bool
+}
+
+func loadReportAccess(ctx context.Context, areaRepository repository.AreaRepository) (*reportAccess, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access := &reportAccess{user: user, supervisedIds: map[int]bool{}}
+
+	if user.Role == model.RoleAreaSupervisor && user.AreaId != 0 {
+		areaIds, err := areaRepository.FindSubtreeIds(ctx, user.AreaId)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range areaIds {
+			access.supervisedIds[id] = true
+		}
+	}
+
+	return access, nil
+}
+
+// scope returns the filter limiting which reports the user may read.
+// The second return value is false when the user may not read any report.
+func (a *reportAccess) scope() (model.ReportFilter, bool) {
+	switch a.user.Role {
+	case model.RoleAdmin, model.RoleAuditor:
+		return model.ReportFilter{}, true
+	case model.RoleAreaSupervisor:
+		if a.user.AreaId == 0 {
+			return model.ReportFilter{}, false
+		}
+		return model.ReportFilter{ScopeAreaId: a.user.AreaId}, true
+	case model.RoleWorker:
+		return model.ReportFilter{UserId: a.user.Id}, true
+	default:
+		return model.ReportFilter{}, false
+	}
+}
+
+func (a *reportAccess) supervises(areaId int) bool {
+	return a.supervisedIds[areaId]
+}
+
+func (a *reportAccess) canView(report *model.Report) bool {
+	switch a.user.Role {
+	case model.RoleAdmin, model.RoleAuditor:
+		return true
+	case model.RoleAreaSupervisor:
+		return a.supervises(report.AreaId)
+	case model.RoleWorker:
+		return report.UserId == a.user.Id
+	default:
+		return false
+	}
+}
+
+// canViewArea reports whether the user may see the consolidated figures of
+// an area. Workers only see their own reports, never an area's.
+func (a *reportAccess) canViewArea(areaId int) bool {
+	switch a.user.Role {
+	case model.RoleAdmin, model.RoleAuditor:
+		return true
+	case model.RoleAreaSupervisor:
+		return a.supervises(areaId)
+	default:
+		return false
+	}
+}
+
+// canCreate reports whether the user may file a report for the worker.
+// Workers may only file reports for the worker record linked to their account.
+func (a *reportAccess) canCreate(worker *model.Worker) bool {
+	switch a.user.Role {
+	case model.RoleAdmin:
+		return true
+	case model.RoleWorker:
+		return worker.UserId == a.user.Id
+	default:
+		return false
+	}
+}
+
+// canModify reports whether the user may edit the report. Supervisors
+// review reports in the areas they oversee, so they may correct them too.
+func (a *reportAccess) canModify(report *model.Report) bool {
+	switch a.user.Role {
+	case model.RoleAdmin:
+		return true
+	case model.RoleAreaSupervisor:
+		return a.supervises(report.AreaId)
+	case model.RoleWorker:
+		return report.UserId == a.user.Id
+	default:
+		return false
+	}
+}
+
+func (a *reportAccess) canDelete(report *model.Report) bool {
+	switch a.user.Role {
+	case model.RoleAdmin:
+		return true
+	case model.RoleWorker:
+		return report.UserId == a.user.Id
+	default:
+		return false
+	}
+}
+
+// canTransition reports whether the user may take the workflow action on the
+// report. Workers submit their own reports; supervisors review, return and
+// approve the reports of the areas they oversee. Only an admin may reopen an
+// approved report.
+func (a *reportAccess) canTransition(report *model.Report, action model.ReportAction) bool {
+	switch action {
+	case model.ActionSubmit:
+		return a.user.Role == model.RoleAdmin || (a.user.Role == model.RoleWorker && report.UserId == a.user.Id)
+	case model.ActionReview, model.ActionReturn, model.ActionApprove:
+		return a.user.Role == model.RoleAdmin || (a.user.Role == model.RoleAreaSupervisor && a.supervises(report.AreaId))
+	case model.ActionReopen:
+		return a.user.Role == model.RoleAdmin
+	default:
+		return false
+	}
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reports/data/request"
+	"reports/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportFlushRows is how many CSV rows are buffered before they are sent.
+const exportFlushRows = 100
+
+// exportWeeks is the number of week columns each activity is flattened into,
+// one per week of the month as weekOfMonth counts them.
+const exportWeeks = 5
+
+// weekOfMonth returns the 0-based week of the month an entry's date falls in:
+// days 1-7 are week 1, and so on. An undated entry keeps its position. Weeks
+// past the last of the given number of columns are folded into it.
+func weekOfMonth(entry model.WeeklyEntry, index int, columns int) int {
+	week := index
+	if date, err := time.Parse(model.DateLayout, entry.Date); err == nil {
+		week = (date.Day() - 1) / 7
+	}
+	if week >= columns {
+		week = columns - 1
+	}
+	return week
+}
+
+// exportRow is a report together with what its columns share, computed once
+// per row: its activities and their counts summed by week of the month.
+type exportRow struct {
+	*model.Report
+	activities []model.ReportActivity
+	weeks      [][exportWeeks]*int
+}
+
+func newExportRow(report *model.Report) *exportRow {
+	row := &exportRow{Report: report, activities: report.Activities()}
+	row.weeks = make([][exportWeeks]*int, len(row.activities))
+	for i, activity := range row.activities {
+		for j, entry := range *activity.Entries {
+			if !entry.Held() {
+				continue
+			}
+			week := weekOfMonth(entry, j, exportWeeks)
+			count := *entry.Count
+			if previous := row.weeks[i][week]; previous != nil {
+				count += *previous
+			}
+			row.weeks[i][week] = &count
+		}
+	}
+	return row
+}
+
+// exportColumn is one column of a report export. Value returns an int,
+// float64, model.Money, string or nil for an empty cell. Summable columns get
+// a total in the XLSX export; money columns are totalled per currency.
+type exportColumn struct {
+	name     string
+	value    func(report *exportRow) interface{}
+	summable bool
+	money    bool
+}
+
+// exportColumnGroup is what a name in the columns parameter selects: a single
+// column, or the week columns of an activity.
+type exportColumnGroup struct {
+	name    string
+	columns []exportColumn
+}
+
+func singleColumn(name string, value func(report *exportRow) interface{}) exportColumnGroup {
+	return exportColumnGroup{name: name, columns: []exportColumn{{name: name, value: value}}}
+}
+
+func summableColumn(name string, value func(report *exportRow) interface{}) exportColumnGroup {
+	return exportColumnGroup{name: name, columns: []exportColumn{{name: name, value: value, summable: true}}}
+}
+
+func moneyColumn(name string, value func(report *exportRow) interface{}) exportColumnGroup {
+	return exportColumnGroup{name: name, columns: []exportColumn{{name: name, value: value, summable: true, money: true}}}
+}
+
+// reportExportGroups lists every selectable group in the default column order.
+func reportExportGroups() []exportColumnGroup {
+	groups := []exportColumnGroup{
+		singleColumn("id", func(report *exportRow) interface{} { return report.Id }),
+		singleColumn("month_of", func(report *exportRow) interface{} { return report.MonthOf.String() }),
+		singleColumn("worker_name", func(report *exportRow) interface{} { return report.WorkerName }),
+		singleColumn("name_of_church", func(report *exportRow) interface{} { return report.NameOfChurch }),
+		singleColumn("area_of_assignment", func(report *exportRow) interface{} { return report.AreaOfAssignment }),
+		singleColumn("status", func(report *exportRow) interface{} { return string(report.Status) }),
+		summableColumn("average_attendance", func(report *exportRow) interface{} { return report.AverageAttendance }),
+	}
+
+	for i, key := range model.ActivityKeys() {
+		index := i
+		weeks := exportColumnGroup{name: key}
+		for week := 0; week < exportWeeks; week++ {
+			week := week
+			weeks.columns = append(weeks.columns, exportColumn{
+				name: fmt.Sprintf("%s_week_%d", key, week+1),
+				value: func(report *exportRow) interface{} {
+					if count := report.weeks[index][week]; count != nil {
+						return *count
+					}
+					return nil
+				},
+				summable: true,
+			})
+		}
+		groups = append(groups, weeks, summableColumn(key+"_average", func(report *exportRow) interface{} {
+			return *report.activities[index].Average
+		}))
+	}
+
+	return append(groups,
+		moneyColumn("tithes", func(report *exportRow) interface{} { return report.Finances.Tithes }),
+		moneyColumn("offerings", func(report *exportRow) interface{} { return report.Finances.Offerings }),
+		moneyColumn("special_gifts", func(report *exportRow) interface{} { return report.Finances.SpecialGifts }),
+		moneyColumn("finances_total", func(report *exportRow) interface{} { return report.Finances.Total() }),
+		singleColumn("currency", func(report *exportRow) interface{} { return report.Finances.Tithes.Currency }),
+		singleColumn("names", func(report *exportRow) interface{} { return strings.Join(report.Names, "; ") }),
+		singleColumn("narrative_report", func(report *exportRow) interface{} { return report.NarrativeReport }),
+		singleColumn("challenges_and_problem_encountered", func(report *exportRow) interface{} {
+			return report.ChallengesAndProblemEncountered
+		}),
+		singleColumn("prayer_request", func(report *exportRow) interface{} { return report.PrayerRequest }),
+		singleColumn("created_at", func(report *exportRow) interface{} { return report.CreatedAt.Format(time.RFC3339) }),
+		singleColumn("updated_at", func(report *exportRow) interface{} { return report.UpdatedAt.Format(time.RFC3339) }),
+	)
+}
+
+// selectExportColumns resolves a comma-separated list of group names into
+// columns. An empty list selects every column.
+func selectExportColumns(selection string) ([]exportColumn, error) {
+	groups := reportExportGroups()
+
+	var columns []exportColumn
+	if strings.TrimSpace(selection) == "" {
+		for _, group := range groups {
+			columns = append(columns, group.columns...)
+		}
+		return columns, nil
+	}
+
+	byName := make(map[string]exportColumnGroup, len(groups))
+	for _, group := range groups {
+		byName[group.name] = group
+	}
+
+	for _, name := range strings.Split(selection, ",") {
+		group, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidFilter, strings.TrimSpace(name))
+		}
+		columns = append(columns, group.columns...)
+	}
+
+	return columns, nil
+}
+
+// formatExportValue writes a cell value as CSV text. Text that a spreadsheet
+// would read as a formula is prefixed with an apostrophe.
+func formatExportValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case model.Money:
+		return v.Amount()
+	case string:
+		if v != "" && strings.ContainsRune("=+-@", rune(v[0])) {
+			return "'" + v
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ExportCSV implements ReportService. Rows are written as they are read from
+// the database; nothing reaches w when the request is rejected.
+func (r *ReportServiceImpl) ExportCSV(ctx context.Context, request *request.ReportExportRequest, w io.Writer) error {
+	columns, err := selectExportColumns(request.Columns)
+	if err != nil {
+		return err
+	}
+
+	filter, ok, err := r.exportFilter(ctx, &request.ReportListRequest)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = column.name
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	if ok {
+		rows := 0
+		err := r.reportRepository.Each(ctx, filter, func(report *model.Report) error {
+			exported := newExportRow(report)
+			for i, column := range columns {
+				record[i] = formatExportValue(column.value(exported))
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+
+			rows++
+			if rows%exportFlushRows == 0 {
+				writer.Flush()
+				return writer.Error()
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// exportFilter builds the filter of an export from the same parameters as
+// GET /api, ignoring paging. The second return value is false when the user
+// may not read any report.
+func (r *ReportServiceImpl) exportFilter(ctx context.Context, request *request.ReportListRequest) (model.ReportFilter, bool, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return model.ReportFilter{}, false, err
+	}
+
+	scope, ok := access.scope()
+	if !ok {
+		return model.ReportFilter{}, false, nil
+	}
+
+	filter, err := applyReportListRequest(scope, request)
+	if err != nil {
+		return model.ReportFilter{}, false, err
+	}
+	filter.Limit = 0
+	filter.Offset = 0
+
+	return filter, true, nil
+}
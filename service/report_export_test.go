@@ -0,0 +1,47 @@
+package service
+
+import (
+	"reports/model"
+	"testing"
+)
+
+func TestNewExportRowWeeks(t *testing.T) {
+	count := func(n int) *int { return &n }
+	report := &model.Report{
+		WorshipService: model.WeeklyEntries{
+			// The 29th and 30th are both in week 5 and are summed.
+			{Date: "2024-09-01", Count: count(40)},
+			{Date: "2024-09-08", Count: nil},
+			{Date: "2024-09-15", Count: count(45)},
+			{Date: "2024-09-22", Count: count(50)},
+			{Date: "2024-09-29", Count: count(30)},
+			{Date: "2024-09-30", Count: count(5)},
+		},
+		// Undated entries keep their position.
+		SundaySchool: counts(20, 25),
+		// Days 8-14 are week 2 whatever the weekday.
+		PrayerMeetings: model.WeeklyEntries{{Date: "2024-09-10", Count: count(12)}},
+	}
+
+	tests := []struct {
+		activity int
+		want     [exportWeeks]interface{}
+	}{
+		{0, [exportWeeks]interface{}{40, nil, 45, 50, 35}},
+		{1, [exportWeeks]interface{}{20, 25, nil, nil, nil}},
+		{2, [exportWeeks]interface{}{nil, 12, nil, nil, nil}},
+	}
+
+	row := newExportRow(report)
+	for _, tt := range tests {
+		var got [exportWeeks]interface{}
+		for week, count := range row.weeks[tt.activity] {
+			if count != nil {
+				got[week] = *count
+			}
+		}
+		if got != tt.want {
+			t.Errorf("weeks of %s = %v, want %v", row.activities[tt.activity].Key, got, tt.want)
+		}
+	}
+}
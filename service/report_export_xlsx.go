@@ -0,0 +1,407 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reports/data/request"
+	"reports/model"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	summarySheetName = "Summary"
+	maxSheetName     = 31
+)
+
+// exportSheet holds the rows of one church's or one month's worksheet.
+type exportSheet struct {
+	title string
+	name  string
+	rows  []exportSheetRow
+}
+
+// exportSheetRow is the cells of one report and the currency of its amounts.
+type exportSheetRow struct {
+	currency string
+	cells    []interface{}
+}
+
+// currencyRange is the rows of a sheet, as 0-based indexes into its rows,
+// whose amounts are in one currency.
+type currencyRange struct {
+	currency    string
+	first, last int
+}
+
+// currencyRanges groups the rows by currency. The rows must be sorted by
+// currency first.
+func (s *exportSheet) currencyRanges() []currencyRange {
+	var ranges []currencyRange
+	for i, row := range s.rows {
+		if n := len(ranges); n > 0 && ranges[n-1].currency == row.currency {
+			ranges[n-1].last = i
+			continue
+		}
+		ranges = append(ranges, currencyRange{currency: row.currency, first: i, last: i})
+	}
+	return ranges
+}
+
+// totalRow returns the row number holding the sheet's totals of amounts in
+// currency, or 0 when no report of the sheet uses it. The row under the
+// reports holds every total when the sheet has a single currency; otherwise
+// it leaves the amounts out and one row per currency follows it.
+func (s *exportSheet) totalRow(currency string) int {
+	ranges := s.currencyRanges()
+	for i, r := range ranges {
+		if r.currency != currency {
+			continue
+		}
+		if len(ranges) == 1 {
+			return len(s.rows) + 2
+		}
+		return len(s.rows) + 3 + i
+	}
+	return 0
+}
+
+// exportStyles are the cell styles shared by every sheet of a workbook.
+type exportStyles struct {
+	header int
+	money  int
+	total  int
+}
+
+// ExportXLSX implements ReportService. The workbook has a summary sheet
+// followed by one sheet per church or per month, each with a frozen header
+// and a row of SUM formulas under the summable columns. The summary refers to
+// those totals, so it stays correct when a sheet is edited. Amounts are never
+// added across currencies: a sheet with several currencies lists its reports
+// grouped by currency with a row of totals for each, and the summary then has
+// a column per currency for every money column.
+func (r *ReportServiceImpl) ExportXLSX(ctx context.Context, request *request.ReportExportRequest, w io.Writer) error {
+	columns, err := selectExportColumns(request.Columns)
+	if err != nil {
+		return err
+	}
+
+	sheetBy := request.SheetBy
+	if sheetBy == "" {
+		sheetBy = "church"
+	}
+	if sheetBy != "church" && sheetBy != "month" {
+		return fmt.Errorf("%w: cannot make a sheet per %q", ErrInvalidFilter, sheetBy)
+	}
+
+	filter, ok, err := r.exportFilter(ctx, &request.ReportListRequest)
+	if err != nil {
+		return err
+	}
+
+	sheets := map[string]*exportSheet{}
+	if ok {
+		err := r.reportRepository.Each(ctx, filter, func(report *model.Report) error {
+			key, title := report.MonthOf.String(), report.MonthOf.String()
+			if sheetBy == "church" {
+				key, title = strconv.Itoa(report.ChurchId), report.NameOfChurch
+			}
+
+			sheet, ok := sheets[key]
+			if !ok {
+				sheet = &exportSheet{title: title}
+				sheets[key] = sheet
+			}
+
+			exported := newExportRow(report)
+			row := exportSheetRow{cells: make([]interface{}, len(columns))}
+			row.currency, _ = report.Finances.Currency()
+			for i, column := range columns {
+				row.cells[i] = column.value(exported)
+			}
+			sheet.rows = append(sheet.rows, row)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	ordered := make([]*exportSheet, 0, len(sheets))
+	for _, sheet := range sheets {
+		rows := sheet.rows
+		sort.SliceStable(rows, func(i, j int) bool {
+			return rows[i].currency < rows[j].currency
+		})
+		ordered = append(ordered, sheet)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].title < ordered[j].title
+	})
+
+	file := excelize.NewFile()
+	defer file.Close()
+
+	styles, err := newExportStyles(file)
+	if err != nil {
+		return err
+	}
+
+	if err := file.SetSheetName("Sheet1", summarySheetName); err != nil {
+		return err
+	}
+
+	used := map[string]bool{strings.ToLower(summarySheetName): true}
+	for _, sheet := range ordered {
+		sheet.name = uniqueSheetName(sheet.title, used)
+		if _, err := file.NewSheet(sheet.name); err != nil {
+			return err
+		}
+		if err := writeExportSheet(file, sheet, columns, styles); err != nil {
+			return err
+		}
+	}
+
+	label := "Church"
+	if sheetBy == "month" {
+		label = "Month"
+	}
+	if err := writeExportSummary(file, label, ordered, columns, styles); err != nil {
+		return err
+	}
+
+	file.SetActiveSheet(0)
+	return file.Write(w)
+}
+
+func newExportStyles(file *excelize.File) (exportStyles, error) {
+	var styles exportStyles
+	var err error
+
+	if styles.header, err = file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}}); err != nil {
+		return styles, err
+	}
+	// Built-in format 4 is "#,##0.00".
+	if styles.money, err = file.NewStyle(&excelize.Style{NumFmt: 4}); err != nil {
+		return styles, err
+	}
+	if styles.total, err = file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}}); err != nil {
+		return styles, err
+	}
+
+	return styles, nil
+}
+
+// writeExportSheet streams the header, the rows and the totals rows of one sheet.
+func writeExportSheet(file *excelize.File, sheet *exportSheet, columns []exportColumn, styles exportStyles) error {
+	writer, err := file.NewStreamWriter(sheet.name)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+
+	header := make([]interface{}, len(columns))
+	for i, column := range columns {
+		header[i] = excelize.Cell{StyleID: styles.header, Value: column.name}
+	}
+	if err := writer.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	for i, row := range sheet.rows {
+		cells := make([]interface{}, len(row.cells))
+		for j, value := range row.cells {
+			cells[j] = xlsxCell(value, styles)
+		}
+		if err := writer.SetRow(cellName(1, i+2), cells); err != nil {
+			return err
+		}
+	}
+
+	ranges := sheet.currencyRanges()
+	all := currencyRange{first: 0, last: len(sheet.rows) - 1}
+	totals := totalsRow("Total", all, columns, func(column exportColumn) bool {
+		return !column.money || len(ranges) == 1
+	}, styles)
+	if err := writer.SetRow(cellName(1, len(sheet.rows)+2), totals); err != nil {
+		return err
+	}
+
+	if len(ranges) > 1 {
+		for i, r := range ranges {
+			totals := totalsRow("Total "+r.currency, r, columns, func(column exportColumn) bool {
+				return column.money
+			}, styles)
+			if err := writer.SetRow(cellName(1, len(sheet.rows)+3+i), totals); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writer.Flush()
+}
+
+// totalsRow sums the summable columns that include accepts over the given
+// rows of a sheet. The label goes in the first column unless it is summable.
+func totalsRow(label string, rows currencyRange, columns []exportColumn, include func(column exportColumn) bool, styles exportStyles) []interface{} {
+	totals := make([]interface{}, len(columns))
+	for i, column := range columns {
+		if !column.summable || !include(column) {
+			continue
+		}
+		letter, _ := excelize.ColumnNumberToName(i + 1)
+		totals[i] = excelize.Cell{StyleID: styles.total, Formula: fmt.Sprintf("SUM(%s%d:%s%d)", letter, rows.first+2, letter, rows.last+2)}
+	}
+	if len(columns) > 0 && !columns[0].summable {
+		totals[0] = excelize.Cell{StyleID: styles.total, Value: label}
+	}
+	return totals
+}
+
+// writeExportSummary lists every sheet with its report count and, for each
+// summable column, a reference to the sheet's total. When the sheets hold
+// more than one currency, each money column becomes one column per currency.
+func writeExportSummary(file *excelize.File, label string, sheets []*exportSheet, columns []exportColumn, styles exportStyles) error {
+	writer, err := file.NewStreamWriter(summarySheetName)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+
+	header := []interface{}{
+		excelize.Cell{StyleID: styles.header, Value: label},
+		excelize.Cell{StyleID: styles.header, Value: "reports"},
+	}
+	seen := map[string]bool{}
+	var currencies []string
+	for _, sheet := range sheets {
+		for _, r := range sheet.currencyRanges() {
+			if !seen[r.currency] {
+				seen[r.currency] = true
+				currencies = append(currencies, r.currency)
+			}
+		}
+	}
+	sort.Strings(currencies)
+
+	// A source with no currency refers to the row right under the reports.
+	type summarySource struct {
+		letter   string
+		currency string
+	}
+	var sources []summarySource
+	for i, column := range columns {
+		if !column.summable {
+			continue
+		}
+		letter, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return err
+		}
+		if !column.money || len(currencies) < 2 {
+			header = append(header, excelize.Cell{StyleID: styles.header, Value: column.name})
+			sources = append(sources, summarySource{letter: letter})
+			continue
+		}
+		for _, currency := range currencies {
+			header = append(header, excelize.Cell{StyleID: styles.header, Value: fmt.Sprintf("%s (%s)", column.name, currency)})
+			sources = append(sources, summarySource{letter: letter, currency: currency})
+		}
+	}
+	if err := writer.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	for i, sheet := range sheets {
+		row := []interface{}{sheet.title, len(sheet.rows)}
+		for _, source := range sources {
+			totalRow := len(sheet.rows) + 2
+			if source.currency != "" {
+				totalRow = sheet.totalRow(source.currency)
+			}
+			if totalRow == 0 {
+				row = append(row, nil)
+				continue
+			}
+			row = append(row, excelize.Cell{Formula: fmt.Sprintf("'%s'!%s%d", strings.ReplaceAll(sheet.name, "'", "''"), source.letter, totalRow)})
+		}
+		if err := writer.SetRow(cellName(1, i+2), row); err != nil {
+			return err
+		}
+	}
+
+	lastRow := len(sheets) + 1
+	totals := []interface{}{excelize.Cell{StyleID: styles.total, Value: "Total"}}
+	for i := 0; i < len(sources)+1; i++ {
+		letter, err := excelize.ColumnNumberToName(i + 2)
+		if err != nil {
+			return err
+		}
+		totals = append(totals, excelize.Cell{StyleID: styles.total, Formula: fmt.Sprintf("SUM(%s2:%s%d)", letter, letter, lastRow)})
+	}
+	if err := writer.SetRow(cellName(1, lastRow+1), totals); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// xlsxCell gives a cell its native type: numbers stay numbers and money is
+// written in major units with two decimals.
+func xlsxCell(value interface{}, styles exportStyles) interface{} {
+	if money, ok := value.(model.Money); ok {
+		amount, _ := strconv.ParseFloat(money.Amount(), 64)
+		return excelize.Cell{StyleID: styles.money, Value: amount}
+	}
+	return value
+}
+
+func frozenHeader() *excelize.Panes {
+	return &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}
+}
+
+func cellName(col int, row int) string {
+	name, _ := excelize.CoordinatesToCellName(col, row)
+	return name
+}
+
+// uniqueSheetName turns a church name or month into a valid sheet name that
+// is not yet used. Sheet names are compared without regard to case.
+func uniqueSheetName(title string, used map[string]bool) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, strings.Trim(strings.TrimSpace(title), "'"))
+	if name == "" {
+		name = "Sheet"
+	}
+
+	candidate := truncateRunes(name, maxSheetName)
+	for n := 2; used[strings.ToLower(candidate)]; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		candidate = truncateRunes(name, maxSheetName-len(suffix)) + suffix
+	}
+
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+func truncateRunes(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) > max {
+		return string(runes[:max])
+	}
+	return value
+}
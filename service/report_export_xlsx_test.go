@@ -0,0 +1,116 @@
+package service
+
+import (
+	"reports/model"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestUniqueSheetName(t *testing.T) {
+	used := map[string]bool{"summary": true}
+	long := "Iglesia ni Cristo sa Barangay San Isidro Labrador"
+
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Grace Church", "Grace Church"},
+		{"grace church", "grace church (2)"},
+		{"GRACE CHURCH", "GRACE CHURCH (3)"},
+		{"Summary", "Summary (2)"},
+		{"North/South: [Main]?", "North_South_ _Main__"},
+		{"'Quoted'", "Quoted"},
+		{"   ", "Sheet"},
+		{"''", "Sheet (2)"},
+		{long, "Iglesia ni Cristo sa Barangay S"},
+		{long, "Iglesia ni Cristo sa Barang (2)"},
+		{"Ñuestra Señora de la Paz y Buen Viaje", "Ñuestra Señora de la Paz y Buen"},
+	}
+
+	for _, tt := range tests {
+		if got := uniqueSheetName(tt.title, used); got != tt.want {
+			t.Errorf("uniqueSheetName(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestExportSheetTotalRow(t *testing.T) {
+	single := &exportSheet{rows: []exportSheetRow{{currency: "PHP"}, {currency: "PHP"}}}
+	mixed := &exportSheet{rows: []exportSheetRow{{currency: "PHP"}, {currency: "PHP"}, {currency: "USD"}}}
+
+	tests := []struct {
+		name     string
+		sheet    *exportSheet
+		currency string
+		want     int
+	}{
+		// Header, two reports, then the totals.
+		{"single currency", single, "PHP", 4},
+		{"missing currency", single, "USD", 0},
+		// Header, three reports, the totals without amounts, then PHP and USD.
+		{"first of two", mixed, "PHP", 6},
+		{"second of two", mixed, "USD", 7},
+	}
+
+	for _, tt := range tests {
+		if got := tt.sheet.totalRow(tt.currency); got != tt.want {
+			t.Errorf("%s: totalRow(%q) = %d, want %d", tt.name, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestWriteExportSheetTotalsPerCurrency(t *testing.T) {
+	columns := []exportColumn{
+		{name: "id"},
+		{name: "average_attendance", summable: true},
+		{name: "tithes", summable: true, money: true},
+	}
+	sheet := &exportSheet{name: "Grace Church", rows: []exportSheetRow{
+		{currency: "PHP", cells: []interface{}{1, 40.0, model.NewMoney(1000, "PHP")}},
+		{currency: "PHP", cells: []interface{}{2, 45.0, model.NewMoney(2000, "PHP")}},
+		{currency: "USD", cells: []interface{}{3, 50.0, model.NewMoney(500, "USD")}},
+	}}
+
+	file := excelize.NewFile()
+	defer file.Close()
+	if _, err := file.NewSheet(sheet.name); err != nil {
+		t.Fatal(err)
+	}
+	styles, err := newExportStyles(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeExportSheet(file, sheet, columns, styles); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		cell  string
+		value string
+		want  string
+	}{
+		{"A5", "Total", ""},
+		{"B5", "", "SUM(B2:B4)"},
+		{"C5", "", ""},
+		{"A6", "Total PHP", ""},
+		{"B6", "", ""},
+		{"C6", "", "SUM(C2:C3)"},
+		{"A7", "Total USD", ""},
+		{"C7", "", "SUM(C4:C4)"},
+	}
+
+	for _, tt := range tests {
+		formula, err := file.GetCellFormula(sheet.name, tt.cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := file.GetCellValue(sheet.name, tt.cell)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if formula != tt.want || (tt.want == "" && value != tt.value) {
+			t.Errorf("%s = %q with formula %q, want %q with formula %q", tt.cell, value, formula, tt.value, tt.want)
+		}
+	}
+}
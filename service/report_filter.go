@@ -0,0 +1,105 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"reports/data/request"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+const (
+	defaultReportPageSize = 20
+)
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// applyReportListRequest copies the query parameters of GET /api onto a scoped filter.
+func applyReportListRequest(filter model.ReportFilter, request *request.ReportListRequest) (model.ReportFilter, error) {
+	filter.AreaId = request.AreaId
+	filter.WorkerId = request.WorkerId
+	filter.ChurchId = request.ChurchId
+	filter.Status = model.ReportStatus(request.Status)
+
+	var err error
+	if filter.MonthFrom, err = parsePeriodParam(request.MonthFrom); err != nil {
+		return filter, err
+	}
+	if filter.MonthTo, err = parsePeriodParam(request.MonthTo); err != nil {
+		return filter, err
+	}
+	if filter.CreatedFrom, err = parseTimeParam("created_from", request.CreatedFrom, false); err != nil {
+		return filter, err
+	}
+	if filter.CreatedTo, err = parseTimeParam("created_to", request.CreatedTo, true); err != nil {
+		return filter, err
+	}
+	if filter.UpdatedFrom, err = parseTimeParam("updated_from", request.UpdatedFrom, false); err != nil {
+		return filter, err
+	}
+	if filter.UpdatedTo, err = parseTimeParam("updated_to", request.UpdatedTo, true); err != nil {
+		return filter, err
+	}
+
+	if request.Sort != "" && !repository.IsReportSortField(request.Sort) {
+		return filter, fmt.Errorf("%w: cannot sort by %q", ErrInvalidFilter, request.Sort)
+	}
+	filter.SortBy = request.Sort
+	filter.SortDesc = request.Order == "desc"
+
+	page, limit := pageAndLimit(request.Page, request.Limit)
+	filter.Limit = limit
+	filter.Offset = (page - 1) * limit
+
+	return filter, nil
+}
+
+func pageAndLimit(page int, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultReportPageSize
+	}
+	return page, limit
+}
+
+// parseTimeParam accepts YYYY-MM-DD or RFC 3339. A bare date used as an
+// upper bound is moved to the next midnight so the whole day is included.
+func parseTimeParam(name string, value string, upperBound bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s must be YYYY-MM-DD or RFC 3339", ErrInvalidFilter, name)
+	}
+
+	if upperBound {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
+
+func parsePeriodParam(value string) (model.Period, error) {
+	if value == "" {
+		return model.Period{}, nil
+	}
+	period, err := model.ParsePeriod(value)
+	if err != nil {
+		return model.Period{}, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	return period, nil
+}
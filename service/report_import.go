@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/xuri/excelize/v2"
+)
+
+var ErrInvalidImport = errors.New("invalid import")
+
+// Report fields an imported column may be mapped to, besides the activity
+// keys and their <key>_week_N columns. An activity key column holds the
+// weekly counts separated by semicolons, e.g. "30;28;;35", where an empty
+// count is a week without a meeting.
+var (
+	importTextFields  = []string{"month_of", "narrative_report", "challenges_and_problem_encountered", "prayer_request"}
+	importIntFields   = []string{"worker_id", "church_id", "area_id"}
+	importMoneyFields = []string{"tithes", "offerings", "special_gifts"}
+)
+
+const (
+	importNamesField             = "names"
+	importCurrencyField          = "currency"
+	importAverageAttendanceField = "average_attendance"
+)
+
+// importTarget is the report field a column is mapped to. Week is the
+// 1-based week of a <key>_week_N column and 0 for every other field.
+type importTarget struct {
+	field string
+	week  int
+}
+
+func (t importTarget) String() string {
+	if t.week > 0 {
+		return fmt.Sprintf("%s_week_%d", t.field, t.week)
+	}
+	return t.field
+}
+
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// parseImportTarget reports which field a mapping target or header names.
+func parseImportTarget(name string) (importTarget, bool) {
+	switch {
+	case containsField(importTextFields, name),
+		containsField(importIntFields, name),
+		containsField(importMoneyFields, name),
+		model.IsActivityKey(name),
+		name == importNamesField,
+		name == importCurrencyField,
+		name == importAverageAttendanceField:
+		return importTarget{field: name}, true
+	}
+
+	if i := strings.LastIndex(name, "_week_"); i > 0 && model.IsActivityKey(name[:i]) {
+		week, err := strconv.Atoi(name[i+len("_week_"):])
+		if err == nil && week >= 1 && week <= exportWeeks {
+			return importTarget{field: name[:i], week: week}, true
+		}
+	}
+
+	return importTarget{}, false
+}
+
+// importColumns resolves the header row into the target of every column, nil
+// for a column that is not imported.
+func importColumns(header []string, mappingJSON string) ([]*importTarget, error) {
+	mapping := map[string]string{}
+	if strings.TrimSpace(mappingJSON) != "" {
+		if err := json.Unmarshal([]byte(mappingJSON), &mapping); err != nil {
+			return nil, fmt.Errorf("%w: mapping must be a JSON object of column names to fields: %v", ErrInvalidImport, err)
+		}
+	}
+
+	for column, field := range mapping {
+		if _, ok := parseImportTarget(field); field != "" && !ok {
+			return nil, fmt.Errorf("%w: column %q is mapped to unknown field %q", ErrInvalidImport, column, field)
+		}
+	}
+
+	targets := make([]*importTarget, len(header))
+	mapped := 0
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		name, ok := mapping[column]
+		if !ok {
+			name = column
+		}
+
+		if target, ok := parseImportTarget(name); ok {
+			targets[i] = &target
+			mapped++
+		}
+	}
+
+	if mapped == 0 {
+		return nil, fmt.Errorf("%w: no column is mapped to a report field", ErrInvalidImport)
+	}
+
+	return targets, nil
+}
+
+// readImportTable reads every row of a CSV file or of the first sheet of an
+// XLSX workbook. The summary sheet an XLSX export starts with is skipped.
+func readImportTable(format string, file io.Reader) ([][]string, error) {
+	switch format {
+	case "csv":
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		if len(rows) > 0 && len(rows[0]) > 0 {
+			rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+		}
+		return rows, nil
+	case "xlsx":
+		workbook, err := excelize.OpenReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		defer workbook.Close()
+
+		var sheets []string
+		for _, sheet := range workbook.GetSheetList() {
+			if !strings.EqualFold(sheet, summarySheetName) {
+				sheets = append(sheets, sheet)
+			}
+		}
+		if len(sheets) == 0 {
+			return nil, fmt.Errorf("%w: the workbook has no sheets", ErrInvalidImport)
+		}
+		// Raw values keep amounts such as 1234.5 free of display formatting.
+		rows, err := workbook.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidImport, format)
+	}
+}
+
+func isBlankRow(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importedReport is a valid row waiting to be saved.
+type importedReport struct {
+	row    int
+	report *model.Report
+}
+
+// Import implements ReportService. Every row is validated like a POST /api
+// body and checked against existing reports. Unless this is a dry run, the
+// valid rows are saved in batches of BatchSize, each in one transaction, and
+// the invalid rows are reported.
+func (r *ReportServiceImpl) Import(ctx context.Context, request *request.ReportImportRequest, file io.Reader) (response.ReportImportResponse, error) {
+	importResp := response.ReportImportResponse{DryRun: request.DryRun, Errors: []response.ImportRowError{}}
+
+	table, err := readImportTable(request.Format, file)
+	if err != nil {
+		return importResp, err
+	}
+	if len(table) == 0 {
+		return importResp, fmt.Errorf("%w: the file has no header row", ErrInvalidImport)
+	}
+
+	targets, err := importColumns(table[0], request.Mapping)
+	if err != nil {
+		return importResp, err
+	}
+
+	var valid []importedReport
+	seen := map[string]int{}
+	for i, record := range table[1:] {
+		row := i + 2
+		if isBlankRow(record) {
+			continue
+		}
+		importResp.Rows++
+
+		report, fields, err := r.importRow(ctx, targets, record)
+		if err != nil {
+			return importResp, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		if len(fields) == 0 {
+			key := fmt.Sprintf("%d/%d/%s", report.WorkerId, report.ChurchId, report.MonthOf)
+			previous, ok := seen[key]
+			if !ok {
+				seen[key] = row
+			}
+
+			if ok {
+				fields = append(fields, response.FieldError{Field: "month_of", Reason: "unique", Param: fmt.Sprintf("row %d", previous)})
+			} else if existingId, err := r.reportRepository.FindIdByPeriod(ctx, report.WorkerId, report.ChurchId, report.MonthOf); err == nil {
+				fields = append(fields, response.FieldError{Field: "month_of", Reason: "unique", Param: fmt.Sprintf("report %d", existingId)})
+			} else if !errors.Is(err, repository.ErrReportNotFound) {
+				return importResp, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+
+		if len(fields) > 0 {
+			importResp.Errors = append(importResp.Errors, response.ImportRowError{Row: row, Fields: fields})
+			continue
+		}
+
+		valid = append(valid, importedReport{row: row, report: report})
+	}
+	importResp.Valid = len(valid)
+
+	if request.DryRun {
+		return importResp, nil
+	}
+
+	batchSize := request.BatchSize
+	if batchSize < 1 {
+		batchSize = len(valid)
+	}
+
+	for start := 0; start < len(valid); start += batchSize {
+		batch := valid[start:min(start+batchSize, len(valid))]
+
+		reports := make([]*model.Report, len(batch))
+		for i, imported := range batch {
+			reports[i] = imported.report
+		}
+
+		err := r.reportRepository.SaveAll(ctx, reports)
+		var batchErr *repository.ReportBatchError
+		switch {
+		case err == nil:
+			importResp.Imported += len(batch)
+		case errors.As(err, &batchErr) && errors.Is(batchErr.Err, repository.ErrReportDuplicate):
+			// Another request saved the same report after the check above.
+			for i, imported := range batch {
+				field := response.FieldError{Field: "row", Reason: "rolled_back"}
+				if i == batchErr.Index {
+					field = response.FieldError{Field: "month_of", Reason: "unique"}
+				}
+				importResp.Errors = append(importResp.Errors, response.ImportRowError{Row: imported.row, Fields: []response.FieldError{field}})
+			}
+		default:
+			return importResp, err
+		}
+	}
+
+	sort.Slice(importResp.Errors, func(i, j int) bool {
+		return importResp.Errors[i].Row < importResp.Errors[j].Row
+	})
+
+	return importResp, nil
+}
+
+// importRow turns one row into a report. Problems with the row's data are
+// returned as field errors; the error is only set when the import cannot go on.
+func (r *ReportServiceImpl) importRow(ctx context.Context, targets []*importTarget, record []string) (*model.Report, []response.FieldError, error) {
+	document, fields := importDocument(targets, record)
+	if len(fields) > 0 {
+		return nil, fields, nil
+	}
+
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var req request.ReportCreateRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, []response.FieldError{{Field: "row", Reason: "type", Param: err.Error()}}, nil
+	}
+
+	if err := patchValidator.Struct(&req); err != nil {
+		var validationErrors validator.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			return nil, nil, err
+		}
+		for _, fieldErr := range validationErrors {
+			namespace := fieldErr.Namespace()
+			if i := strings.Index(namespace, "."); i >= 0 {
+				namespace = namespace[i+1:]
+			}
+			fields = append(fields, response.FieldError{Field: namespace, Reason: fieldErr.Tag(), Param: fieldErr.Param()})
+		}
+		return nil, fields, nil
+	}
+
+	_, report, err := r.newReport(ctx, &req)
+	if err != nil {
+		if field, ok := importFieldError(err); ok {
+			return nil, []response.FieldError{field}, nil
+		}
+		return nil, nil, err
+	}
+
+	return report, nil, nil
+}
+
+// importDocument turns the cells of a row into a POST /api body, or into
+// field errors for the cells that cannot be read.
+func importDocument(targets []*importTarget, record []string) (map[string]interface{}, []response.FieldError) {
+	document := map[string]interface{}{}
+	weeks := map[string][]*int{}
+	amounts := map[string]string{}
+	currency := ""
+	var fields []response.FieldError
+
+	invalid := func(target importTarget, reason string, param string) {
+		fields = append(fields, response.FieldError{Field: target.String(), Reason: reason, Param: param})
+	}
+
+	for i, target := range targets {
+		if target == nil || i >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+
+		switch {
+		case target.week > 0:
+			count, err := strconv.Atoi(value)
+			if err != nil {
+				invalid(*target, "type", "int")
+				continue
+			}
+			entries := weeks[target.field]
+			for len(entries) < target.week {
+				entries = append(entries, nil)
+			}
+			entries[target.week-1] = &count
+			weeks[target.field] = entries
+		case model.IsActivityKey(target.field):
+			var entries []*int
+			for _, part := range strings.Split(value, ";") {
+				if part = strings.TrimSpace(part); part == "" {
+					entries = append(entries, nil)
+					continue
+				}
+				count, err := strconv.Atoi(part)
+				if err != nil {
+					invalid(*target, "type", "int")
+					break
+				}
+				entries = append(entries, &count)
+			}
+			weeks[target.field] = entries
+		case containsField(importIntFields, target.field):
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				invalid(*target, "type", "int")
+				continue
+			}
+			document[target.field] = id
+		case containsField(importMoneyFields, target.field):
+			if _, err := model.ParseAmount(value); err != nil {
+				invalid(*target, "amount", "")
+				continue
+			}
+			amounts[target.field] = value
+		case target.field == importCurrencyField:
+			currency = strings.ToUpper(value)
+		case target.field == importAverageAttendanceField:
+			average, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				invalid(*target, "type", "float64")
+				continue
+			}
+			document[target.field] = average
+		case target.field == importNamesField:
+			var names []string
+			for _, name := range strings.FieldsFunc(value, func(c rune) bool { return c == ';' || c == '\n' }) {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+			document[target.field] = names
+		default:
+			document[target.field] = value
+		}
+	}
+
+	if len(fields) > 0 {
+		return nil, fields
+	}
+
+	for key, entries := range weeks {
+		// Empty trailing weeks are weeks the month does not have.
+		for len(entries) > 0 && entries[len(entries)-1] == nil {
+			entries = entries[:len(entries)-1]
+		}
+		document[key] = entries
+	}
+
+	if len(amounts) > 0 || currency != "" {
+		finances := map[string]interface{}{}
+		for _, field := range importMoneyFields {
+			amount, ok := amounts[field]
+			if !ok {
+				amount = "0"
+			}
+			finances[field] = map[string]string{"amount": amount, "currency": currency}
+		}
+		document["finances"] = finances
+	}
+
+	return document, nil
+}
+
+// importFieldError describes an error of newReport that is caused by the
+// row's data.
+func importFieldError(err error) (response.FieldError, bool) {
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return response.FieldError{Field: validationErr.Field, Reason: validationErr.Reason, Param: validationErr.Param}, true
+	case errors.Is(err, repository.ErrWorkerNotFound):
+		return response.FieldError{Field: "worker_id", Reason: "exists"}, true
+	case errors.Is(err, repository.ErrChurchNotFound):
+		return response.FieldError{Field: "church_id", Reason: "exists"}, true
+	case errors.Is(err, repository.ErrAreaNotFound):
+		return response.FieldError{Field: "area_id", Reason: "exists"}, true
+	case errors.Is(err, ErrReportAreaRequired):
+		return response.FieldError{Field: "area_id", Reason: "required"}, true
+	case errors.Is(err, model.ErrInvalidPeriod):
+		return response.FieldError{Field: "month_of", Reason: "datetime", Param: "2006-01"}, true
+	case errors.Is(err, ErrAverageAttendanceMismatch):
+		return response.FieldError{Field: "average_attendance", Reason: "mismatch"}, true
+	case errors.Is(err, ErrForbidden):
+		return response.FieldError{Field: "worker_id", Reason: "forbidden"}, true
+	default:
+		return response.FieldError{}, false
+	}
+}
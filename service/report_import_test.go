@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"reports/data/response"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+func TestParseImportTarget(t *testing.T) {
+	tests := []struct {
+		name string
+		want importTarget
+		ok   bool
+	}{
+		{"month_of", importTarget{field: "month_of"}, true},
+		{"tithes", importTarget{field: "tithes"}, true},
+		{"currency", importTarget{field: "currency"}, true},
+		{"worship_service", importTarget{field: "worship_service"}, true},
+		{"worship_service_week_1", importTarget{field: "worship_service", week: 1}, true},
+		{"bible_studies_week_5", importTarget{field: "bible_studies", week: 5}, true},
+		{"bible_studies_week_6", importTarget{}, false},
+		{"bible_studies_week_0", importTarget{}, false},
+		{"bible_studies_week_x", importTarget{}, false},
+		{"unknown_week_1", importTarget{}, false},
+		{"id", importTarget{}, false},
+		{"", importTarget{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseImportTarget(tt.name)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseImportTarget(%q) = %+v, %v; want %+v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestImportColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  []string
+		mapping string
+		want    []*importTarget
+		wantErr bool
+	}{
+		{
+			name:   "export headers",
+			header: []string{"id", " month_of ", "worship_service_week_2"},
+			want:   []*importTarget{nil, {field: "month_of"}, {field: "worship_service", week: 2}},
+		},
+		{
+			name:    "mapped headers",
+			header:  []string{"Month", "Church", "Notes"},
+			mapping: `{"Month": "month_of", "Church": "church_id", "Notes": ""}`,
+			want:    []*importTarget{{field: "month_of"}, {field: "church_id"}, nil},
+		},
+		{
+			name:    "mapped to an unknown field",
+			header:  []string{"Month"},
+			mapping: `{"Month": "period"}`,
+			wantErr: true,
+		},
+		{
+			name:    "mapping is not an object",
+			header:  []string{"month_of"},
+			mapping: `["month_of"]`,
+			wantErr: true,
+		},
+		{
+			name:    "nothing mapped",
+			header:  []string{"id", "created_at"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := importColumns(tt.header, tt.mapping)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidImport) {
+				t.Errorf("%s: importColumns() error = %v, want ErrInvalidImport", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: importColumns() = %v, %v; want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestImportDocument(t *testing.T) {
+	header := []string{"month_of", "church_id", "worship_service", "sunday_school_week_1", "sunday_school_week_3", "sunday_school_week_5", "names", "tithes", "currency"}
+	targets, err := importColumns(header, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		record []string
+		want   string
+		fields []response.FieldError
+	}{
+		{
+			name:   "every kind of cell",
+			record: []string{"2024-03", "7", "30; 28;;35", "20", "22", "", "Ana; Ben\nCarla", "1234.5", "php"},
+			want: `{"church_id":7,"finances":{"offerings":{"amount":"0","currency":"PHP"},"special_gifts":{"amount":"0","currency":"PHP"},"tithes":{"amount":"1234.5","currency":"PHP"}},` +
+				`"month_of":"2024-03","names":["Ana","Ben","Carla"],"sunday_school":[20,null,22],"worship_service":[30,28,null,35]}`,
+		},
+		{
+			name:   "short row without finances",
+			record: []string{"2024-03", "7"},
+			want:   `{"church_id":7,"month_of":"2024-03"}`,
+		},
+		{
+			name:   "unreadable cells",
+			record: []string{"2024-03", "seven", "30;x", "", "", "1.5", "", "12.345", ""},
+			fields: []response.FieldError{
+				{Field: "church_id", Reason: "type", Param: "int"},
+				{Field: "worship_service", Reason: "type", Param: "int"},
+				{Field: "sunday_school_week_5", Reason: "type", Param: "int"},
+				{Field: "tithes", Reason: "amount"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		document, fields := importDocument(targets, tt.record)
+		if !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("%s: fields = %+v, want %+v", tt.name, fields, tt.fields)
+			continue
+		}
+		if tt.fields != nil {
+			continue
+		}
+		body, err := json.Marshal(document)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != tt.want {
+			t.Errorf("%s: document = %s, want %s", tt.name, body, tt.want)
+		}
+	}
+}
+
+func TestReadImportTableCSV(t *testing.T) {
+	rows, err := readImportTable("csv", strings.NewReader("\ufeffmonth_of,church_id\n2024-03,7,extra\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"month_of", "church_id"}, {"2024-03", "7", "extra"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("readImportTable() = %q, want %q", rows, want)
+	}
+
+	if _, err := readImportTable("ods", strings.NewReader("")); !errors.Is(err, ErrInvalidImport) {
+		t.Errorf("readImportTable(ods) error = %v, want ErrInvalidImport", err)
+	}
+}
+
+func TestReadImportTableSkipsSummary(t *testing.T) {
+	file := excelize.NewFile()
+	defer file.Close()
+	if err := file.SetSheetName("Sheet1", summarySheetName); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.SetCellValue(summarySheetName, "A1", "Church"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.NewSheet("Grace Church"); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.SetSheetRow("Grace Church", "A1", &[]interface{}{"month_of", "tithes"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.SetSheetRow("Grace Church", "A2", &[]interface{}{"2024-03", 1234.5}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := file.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := readImportTable("xlsx", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"month_of", "tithes"}, {"2024-03", "1234.5"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("readImportTable() = %q, want %q", rows, want)
+	}
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reports/model"
+	"strconv"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// pdfWeeks is the number of week columns on the paper form.
+const pdfWeeks = 5
+
+// activityLabels are the row labels the paper form uses for each activity.
+var activityLabels = map[string]string{
+	"worship_service":            "Worship Service",
+	"sunday_school":              "Sunday School",
+	"prayer_meetings":            "Prayer Meetings",
+	"bible_studies":              "Bible Studies",
+	"mens_fellowships":           "Men's Fellowships",
+	"womens_fellowships":         "Women's Fellowships",
+	"youth_fellowships":          "Youth Fellowships",
+	"child_fellowships":          "Children's Fellowships",
+	"outreach":                   "Outreach",
+	"training_or_seminars":       "Training or Seminars",
+	"leadership_conferences":     "Leadership Conferences",
+	"leadership_training":        "Leadership Training",
+	"others":                     "Others",
+	"family_days":                "Family Days",
+	"home_visited":               "Homes Visited",
+	"bible_study_or_group_led":   "Bible Studies or Groups Led",
+	"sermon_or_message_preached": "Sermons or Messages Preached",
+	"person_newly_contacted":     "Persons Newly Contacted",
+	"person_followed_up":         "Persons Followed Up",
+	"person_led_to_christ":       "Persons Led to Christ",
+}
+
+// pastoralActivities are printed as monthly counts rather than in the weekly
+// attendance grid.
+var pastoralActivities = map[string]bool{
+	"home_visited":               true,
+	"bible_study_or_group_led":   true,
+	"sermon_or_message_preached": true,
+	"person_newly_contacted":     true,
+	"person_followed_up":         true,
+	"person_led_to_christ":       true,
+}
+
+// RenderPDF implements ReportService.
+func (r *ReportServiceImpl) RenderPDF(ctx context.Context, reportId int, w io.Writer) error {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return err
+	}
+
+	report, err := r.reportRepository.FindById(ctx, reportId)
+	if err != nil {
+		return err
+	}
+
+	if !access.canView(report) {
+		return ErrForbidden
+	}
+
+	return newReportPDF(report).Output(w)
+}
+
+// reportPDF lays a report out like the paper monthly report form.
+type reportPDF struct {
+	pdf    *fpdf.Fpdf
+	tr     func(string) string
+	width  float64
+	report *model.Report
+}
+
+func newReportPDF(report *model.Report) *fpdf.Fpdf {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetTitle(fmt.Sprintf("Monthly Report %s - %s", report.MonthOf, report.NameOfChurch), true)
+	pdf.AddPage()
+
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+
+	form := &reportPDF{
+		pdf:    pdf,
+		tr:     pdf.UnicodeTranslatorFromDescriptor(""),
+		width:  pageWidth - left - right,
+		report: report,
+	}
+
+	form.header()
+	form.attendanceGrid()
+	form.pastoralActivities()
+	form.finances()
+	form.names()
+	form.textBlock("Narrative Report", report.NarrativeReport)
+	form.textBlock("Challenges and Problems Encountered", report.ChallengesAndProblemEncountered)
+	form.textBlock("Prayer Request", report.PrayerRequest)
+	form.signatures()
+
+	return pdf
+}
+
+func (f *reportPDF) heading(title string) {
+	f.pdf.Ln(4)
+	f.pdf.SetFont("Helvetica", "B", 11)
+	f.pdf.CellFormat(f.width, 7, f.tr(title), "", 1, "L", false, 0, "")
+}
+
+func (f *reportPDF) header() {
+	f.pdf.SetFont("Helvetica", "B", 16)
+	f.pdf.CellFormat(f.width, 9, "MONTHLY REPORT", "", 1, "C", false, 0, "")
+	f.pdf.SetFont("Helvetica", "", 11)
+	f.pdf.CellFormat(f.width, 6, f.report.MonthOf.Start().Format("January 2006"), "", 1, "C", false, 0, "")
+	f.pdf.Ln(4)
+
+	fields := [][2]string{
+		{"Worker", f.report.WorkerName},
+		{"Church", f.report.NameOfChurch},
+		{"Area", f.report.AreaOfAssignment},
+		{"Month", f.report.MonthOf.Start().Format("January 2006")},
+	}
+	labelWidth := 25.0
+	for _, field := range fields {
+		f.pdf.SetFont("Helvetica", "B", 10)
+		f.pdf.CellFormat(labelWidth, 7, field[0]+":", "", 0, "L", false, 0, "")
+		f.pdf.SetFont("Helvetica", "", 10)
+		f.pdf.CellFormat(f.width-labelWidth, 7, f.tr(field[1]), "B", 1, "L", false, 0, "")
+	}
+}
+
+// attendanceGrid prints one row per weekly activity with a column per week
+// of the month and the activity's average. Weeks not held are left blank.
+func (f *reportPDF) attendanceGrid() {
+	f.heading("Weekly Attendance")
+
+	labelWidth := 55.0
+	averageWidth := 25.0
+	weekWidth := (f.width - labelWidth - averageWidth) / pdfWeeks
+
+	f.pdf.SetFont("Helvetica", "B", 9)
+	f.pdf.SetFillColor(230, 230, 230)
+	f.pdf.CellFormat(labelWidth, 7, "Activity", "1", 0, "L", true, 0, "")
+	for week := 1; week <= pdfWeeks; week++ {
+		f.pdf.CellFormat(weekWidth, 7, fmt.Sprintf("Week %d", week), "1", 0, "C", true, 0, "")
+	}
+	f.pdf.CellFormat(averageWidth, 7, "Average", "1", 1, "C", true, 0, "")
+
+	f.pdf.SetFont("Helvetica", "", 9)
+	for _, activity := range f.report.Activities() {
+		if pastoralActivities[activity.Key] {
+			continue
+		}
+
+		weeks := weekCounts(*activity.Entries)
+		f.pdf.CellFormat(labelWidth, 7, f.tr(activityLabels[activity.Key]), "1", 0, "L", false, 0, "")
+		for _, count := range weeks {
+			f.pdf.CellFormat(weekWidth, 7, count, "1", 0, "C", false, 0, "")
+		}
+		f.pdf.CellFormat(averageWidth, 7, formatPDFNumber(*activity.Average), "1", 1, "C", false, 0, "")
+	}
+
+	f.pdf.SetFont("Helvetica", "B", 9)
+	f.pdf.CellFormat(f.width-averageWidth, 7, "Average Attendance", "1", 0, "R", false, 0, "")
+	f.pdf.CellFormat(averageWidth, 7, formatPDFNumber(f.report.AverageAttendance), "1", 1, "C", false, 0, "")
+}
+
+// weekCounts places each held entry in the week of the month its date falls
+// in, as weekOfMonth counts them.
+func weekCounts(entries model.WeeklyEntries) [pdfWeeks]string {
+	var weeks [pdfWeeks]string
+	for i, entry := range entries {
+		if !entry.Held() {
+			continue
+		}
+
+		week := weekOfMonth(entry, i, pdfWeeks)
+		count := *entry.Count
+		if weeks[week] != "" {
+			previous, _ := strconv.Atoi(weeks[week])
+			count += previous
+		}
+		weeks[week] = strconv.Itoa(count)
+	}
+	return weeks
+}
+
+func (f *reportPDF) pastoralActivities() {
+	f.heading("Pastoral Activities")
+
+	countWidth := 25.0
+	f.pdf.SetFont("Helvetica", "", 9)
+	for _, activity := range f.report.Activities() {
+		if !pastoralActivities[activity.Key] {
+			continue
+		}
+
+		total := 0
+		for _, count := range activity.Entries.Counts() {
+			total += count
+		}
+		f.pdf.CellFormat(f.width-countWidth, 7, f.tr(activityLabels[activity.Key]), "1", 0, "L", false, 0, "")
+		f.pdf.CellFormat(countWidth, 7, strconv.Itoa(total), "1", 1, "C", false, 0, "")
+	}
+}
+
+func (f *reportPDF) finances() {
+	f.heading("Tithes and Offerings")
+
+	amountWidth := 45.0
+	rows := []struct {
+		label  string
+		amount model.Money
+		style  string
+	}{
+		{"Tithes", f.report.Finances.Tithes, ""},
+		{"Offerings", f.report.Finances.Offerings, ""},
+		{"Special Gifts", f.report.Finances.SpecialGifts, ""},
+		{"Total", f.report.Finances.Total(), "B"},
+	}
+	for _, row := range rows {
+		f.pdf.SetFont("Helvetica", row.style, 9)
+		f.pdf.CellFormat(f.width-amountWidth, 7, row.label, "1", 0, "L", false, 0, "")
+		f.pdf.CellFormat(amountWidth, 7, row.amount.String(), "1", 1, "R", false, 0, "")
+	}
+}
+
+func (f *reportPDF) names() {
+	f.heading("Names of Persons Led to Christ")
+
+	f.pdf.SetFont("Helvetica", "", 9)
+	if len(f.report.Names) == 0 {
+		f.pdf.CellFormat(f.width, 7, "None", "1", 1, "L", false, 0, "")
+		return
+	}
+
+	lines := make([]string, len(f.report.Names))
+	for i, name := range f.report.Names {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, name)
+	}
+	f.pdf.MultiCell(f.width, 6, f.tr(strings.Join(lines, "\n")), "1", "L", false)
+}
+
+func (f *reportPDF) textBlock(title string, text string) {
+	f.heading(title)
+
+	f.pdf.SetFont("Helvetica", "", 9)
+	if strings.TrimSpace(text) == "" {
+		text = " "
+	}
+	f.pdf.MultiCell(f.width, 5, f.tr(text), "1", "L", false)
+}
+
+// signatures prints the lines the worker and the supervisor sign on.
+func (f *reportPDF) signatures() {
+	_, pageHeight := f.pdf.GetPageSize()
+	_, _, _, bottom := f.pdf.GetMargins()
+	if f.pdf.GetY()+40 > pageHeight-bottom {
+		f.pdf.AddPage()
+	}
+	f.pdf.Ln(15)
+
+	columnWidth := (f.width - 10) / 2
+	left, _, _, _ := f.pdf.GetMargins()
+	y := f.pdf.GetY()
+
+	blocks := []struct {
+		caption string
+		name    string
+	}{
+		{"Prepared by", f.report.WorkerName},
+		{"Noted by", "Area Supervisor"},
+	}
+	for i, block := range blocks {
+		x := left + float64(i)*(columnWidth+10)
+		f.pdf.SetXY(x, y)
+		f.pdf.SetFont("Helvetica", "", 9)
+		f.pdf.CellFormat(columnWidth, 5, block.caption+":", "", 2, "L", false, 0, "")
+		f.pdf.Ln(10)
+		f.pdf.SetX(x)
+		f.pdf.SetFont("Helvetica", "B", 10)
+		f.pdf.CellFormat(columnWidth, 6, f.tr(block.name), "T", 2, "C", false, 0, "")
+		f.pdf.SetFont("Helvetica", "", 8)
+		f.pdf.CellFormat(columnWidth, 5, "Signature over printed name", "", 2, "C", false, 0, "")
+		f.pdf.Ln(6)
+		f.pdf.SetX(x)
+		f.pdf.CellFormat(columnWidth, 5, "Date: ____________________", "", 2, "L", false, 0, "")
+	}
+}
+
+func formatPDFNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
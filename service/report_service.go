@@ -2,14 +2,24 @@ package service
 
 import (
 	"context"
+	"io"
 	"reports/data/request"
 	"reports/data/response"
+	"reports/model"
 )
 
 type ReportService interface {
 	Create(ctx context.Context, request *request.ReportCreateRequest) error
+	Upsert(ctx context.Context, request *request.ReportCreateRequest) (bool, error)
 	Update(ctx context.Context, request *request.ReportUpdateRequest) error
+	Patch(ctx context.Context, reportId int, patch []byte) (response.ReportResponse, error)
 	Delete(ctx context.Context, reportId int) error
 	FindById(ctx context.Context, reportId int) (response.ReportResponse, error)
-	FindAll(ctx context.Context) ([]response.ReportResponse, error)
+	RenderPDF(ctx context.Context, reportId int, w io.Writer) error
+	FindAll(ctx context.Context, request *request.ReportListRequest) ([]response.ReportResponse, response.Pagination, error)
+	ExportCSV(ctx context.Context, request *request.ReportExportRequest, w io.Writer) error
+	ExportXLSX(ctx context.Context, request *request.ReportExportRequest, w io.Writer) error
+	Transition(ctx context.Context, reportId int, action model.ReportAction, note string) (response.ReportResponse, error)
+	History(ctx context.Context, reportId int) ([]response.ReportStatusChangeResponse, error)
+	Import(ctx context.Context, request *request.ReportImportRequest, file io.Reader) (response.ReportImportResponse, error)
 }
@@ -2,35 +2,125 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"reports/config"
 	"reports/data/request"
 	"reports/data/response"
+	"reports/helper"
 	"reports/model"
 	"reports/repository"
 	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrReportAreaRequired        = errors.New("area_id is required when the church has no area")
+	ErrAverageAttendanceMismatch = errors.New("average_attendance does not match the weekly attendance")
+	ErrInvalidPatch              = errors.New("invalid merge patch")
 )
 
+// patchValidator checks a patched report against the same binding tags
+// gin applies to a PUT body.
+var patchValidator = newBindingValidator()
+
+func newBindingValidator() *validator.Validate {
+	validate := validator.New()
+	validate.SetTagName("binding")
+	helper.RegisterJSONFieldNames(validate)
+	return validate
+}
+
+// ReportConflictError is returned when a worker already has a report for the
+// same church and month. Use Upsert to replace that report instead.
+type ReportConflictError struct {
+	ExistingReportId int
+}
+
+func (e *ReportConflictError) Error() string {
+	return fmt.Sprintf("report %d already covers this worker, church and month", e.ExistingReportId)
+}
+
+func (e *ReportConflictError) Unwrap() error {
+	return repository.ErrReportDuplicate
+}
+
 type ReportServiceImpl struct {
-	reportRepository repository.ReportRepository
+	reportRepository  repository.ReportRepository
+	workerRepository  repository.WorkerRepository
+	churchRepository  repository.ChurchRepository
+	areaRepository    repository.AreaRepository
+	attendanceFormula model.AttendanceFormula
+	warnOnMismatch    bool
+	statsPrecision    int
 }
 
-func NewReportServiceImpl(reportRepository repository.ReportRepository) ReportService {
-	return &ReportServiceImpl{reportRepository: reportRepository}
+func NewReportServiceImpl(reportRepository repository.ReportRepository, workerRepository repository.WorkerRepository, churchRepository repository.ChurchRepository, areaRepository repository.AreaRepository, config *config.Config) ReportService {
+	formula, err := model.ParseAttendanceFormula(config.AverageAttendanceFormula)
+	helper.ErrorPanic(err)
+	check, err := model.ParseAttendanceCheck(config.AverageAttendanceCheck)
+	helper.ErrorPanic(err)
+
+	return &ReportServiceImpl{
+		reportRepository:  reportRepository,
+		workerRepository:  workerRepository,
+		churchRepository:  churchRepository,
+		areaRepository:    areaRepository,
+		attendanceFormula: formula,
+		warnOnMismatch:    check == model.AttendanceCheckWarn,
+		statsPrecision:    config.StatsPrecision,
+	}
 }
 
-func (r *ReportServiceImpl) Create(ctx context.Context, request *request.ReportCreateRequest) error {
+// newReport validates a create request and builds the report it describes.
+func (r *ReportServiceImpl) newReport(ctx context.Context, request *request.ReportCreateRequest) (*reportAccess, *model.Report, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	worker, err := r.workerRepository.FindById(ctx, request.WorkerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !access.canCreate(worker) {
+		return nil, nil, ErrForbidden
+	}
+
+	church, err := r.churchRepository.FindById(ctx, request.ChurchId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	areaId, err := r.resolveAreaId(ctx, request.AreaId, church)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	period, err := model.ParsePeriod(request.MonthOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	loc, err := time.LoadLocation("Asia/Manila")
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	now := time.Now().In(loc)
 
-	report := model.Report{
-		MonthOf:                         request.MonthOf,
-		WorkerName:                      request.WorkerName,
-		AreaOfAssignment:                request.AreaOfAssignment,
-		NameOfChurch:                    request.NameOfChurch,
+	report := &model.Report{
+		UserId:                          access.user.Id,
+		MonthOf:                         period,
+		WorkerId:                        worker.Id,
+		AreaId:                          areaId,
+		ChurchId:                        church.Id,
+		Status:                          model.StatusDraft,
 		WorshipService:                  request.WorshipService,
 		SundaySchool:                    request.SundaySchool,
 		PrayerMeetings:                  request.PrayerMeetings,
@@ -45,8 +135,6 @@ func (r *ReportServiceImpl) Create(ctx context.Context, request *request.ReportC
 		LeadershipTraining:              request.LeadershipTraining,
 		Others:                          request.Others,
 		FamilyDays:                      request.FamilyDays,
-		TithesAndOfferings:              request.TithesAndOfferings,
-		AverageAttendance:               request.AverageAttendance,
 		HomeVisited:                     request.HomeVisited,
 		BibleStudyOrGroupLed:            request.BibleStudyOrGroupLed,
 		SermonOrMessagePreached:         request.SermonOrMessagePreached,
@@ -57,26 +145,99 @@ func (r *ReportServiceImpl) Create(ctx context.Context, request *request.ReportC
 		NarrativeReport:                 request.NarrativeReport,
 		ChallengesAndProblemEncountered: request.ChallengesAndProblemEncountered,
 		PrayerRequest:                   request.PrayerRequest,
+		Finances:                        request.Finances,
 		CreatedAt:                       now,
 		UpdatedAt:                       now,
 	}
+	if err := dateEntries(report); err != nil {
+		return nil, nil, err
+	}
+	if err := checkFinances(report.Finances); err != nil {
+		return nil, nil, err
+	}
+	report.ComputeAverages(r.attendanceFormula)
+
+	if err := r.checkAverageAttendance(request.AverageAttendance, report.AverageAttendance); err != nil {
+		return nil, nil, err
+	}
+
+	return access, report, nil
+}
+
+func (r *ReportServiceImpl) Create(ctx context.Context, request *request.ReportCreateRequest) error {
+	_, report, err := r.newReport(ctx, request)
+	if err != nil {
+		return err
+	}
 
 	// Save the report using the repository
-	err = r.reportRepository.Save(ctx, &report)
+	err = r.reportRepository.Save(ctx, report)
 	if err != nil {
-		return fmt.Errorf("failed to save report: %w", err)
+		return fmt.Errorf("failed to save report: %w", r.asConflict(ctx, report, err))
 	}
 
 	return nil
 }
 
+// Upsert creates the report, or replaces the one already filed for the same
+// worker, church and month. It reports whether a new report was created.
+func (r *ReportServiceImpl) Upsert(ctx context.Context, request *request.ReportCreateRequest) (bool, error) {
+	access, report, err := r.newReport(ctx, request)
+	if err != nil {
+		return false, err
+	}
+
+	// The report being replaced is read first for the permission checks; the
+	// write itself is a single statement, so a report created in between is
+	// still replaced rather than duplicated.
+	existingId, err := r.reportRepository.FindIdByPeriod(ctx, report.WorkerId, report.ChurchId, report.MonthOf)
+	switch {
+	case err == nil:
+		existing, err := r.reportRepository.FindById(ctx, existingId)
+		if err != nil {
+			return false, err
+		}
+		if !access.canModify(existing) {
+			return false, ErrForbidden
+		}
+		if existing.Status.Locked() {
+			return false, ErrReportLocked
+		}
+		report.UserId = existing.UserId
+		if !access.canModify(report) {
+			return false, ErrForbidden
+		}
+	case !errors.Is(err, repository.ErrReportNotFound):
+		return false, err
+	}
+
+	created, err := r.reportRepository.Upsert(ctx, report)
+	if err != nil {
+		return false, fmt.Errorf("failed to save report: %w", err)
+	}
+
+	return created, nil
+}
+
 func (r *ReportServiceImpl) Delete(ctx context.Context, reportId int) error {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return err
+	}
+
 	// Retrieve the report by its ID
 	report, err := r.reportRepository.FindById(ctx, reportId)
 	if err != nil {
 		return err // Return error if FindById fails
 	}
 
+	if !access.canDelete(report) {
+		return ErrForbidden
+	}
+	if report.Status.Locked() {
+		return ErrReportLocked
+	}
+
 	// Delete the report using its ID
 	err = r.reportRepository.Delete(ctx, report.Id)
 	if err != nil {
@@ -86,91 +247,291 @@ func (r *ReportServiceImpl) Delete(ctx context.Context, reportId int) error {
 	return nil
 }
 
-func (r *ReportServiceImpl) FindAll(ctx context.Context) ([]response.ReportResponse, error) {
-	reports, err := r.reportRepository.FindAll(ctx)
-	if err != nil {
-		return nil, err // Return error if FindAll fails
-	}
-
-	var reportResp []response.ReportResponse
-
-	for _, value := range reports {
-		report := response.ReportResponse{
-			Id:                              value.Id,
-			MonthOf:                         value.MonthOf,
-			WorkerName:                      value.WorkerName,
-			AreaOfAssignment:                value.AreaOfAssignment,
-			NameOfChurch:                    value.NameOfChurch,
-			WorshipService:                  value.WorshipService,
-			SundaySchool:                    value.SundaySchool,
-			PrayerMeetings:                  value.PrayerMeetings,
-			BibleStudies:                    value.BibleStudies,
-			MensFellowships:                 value.MensFellowships,
-			WomensFellowships:               value.WomensFellowships,
-			YouthFellowships:                value.YouthFellowships,
-			ChildFellowships:                value.ChildFellowships,
-			Outreach:                        value.Outreach,
-			TrainingOrSeminars:              value.TrainingOrSeminars,
-			LeadershipConferences:           value.LeadershipConferences,
-			LeadershipTraining:              value.LeadershipTraining,
-			Others:                          value.Others,
-			FamilyDays:                      value.FamilyDays,
-			TithesAndOfferings:              value.TithesAndOfferings,
-			HomeVisited:                     value.HomeVisited,
-			BibleStudyOrGroupLed:            value.BibleStudyOrGroupLed,
-			SermonOrMessagePreached:         value.SermonOrMessagePreached,
-			PersonNewlyContacted:            value.PersonNewlyContacted,
-			PersonFollowedUp:                value.PersonFollowedUp,
-			PersonLedToChrist:               value.PersonLedToChrist,
-			Names:                           value.Names,
-			NarrativeReport:                 value.NarrativeReport,
-			ChallengesAndProblemEncountered: value.ChallengesAndProblemEncountered,
-			PrayerRequest:                   value.PrayerRequest,
-			CreatedAt:                       value.CreatedAt,
-			UpdatedAt:                       value.UpdatedAt,
-		}
+func (r *ReportServiceImpl) FindAll(ctx context.Context, request *request.ReportListRequest) ([]response.ReportResponse, response.Pagination, error) {
+	page, limit := pageAndLimit(request.Page, request.Limit)
+	pagination := response.Pagination{Page: page, Limit: limit}
+
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return nil, pagination, err
+	}
+
+	scope, ok := access.scope()
+	if !ok {
+		return []response.ReportResponse{}, pagination, nil
+	}
 
-		// Calculate average attendance for each type
-		report.WorshipServiceAvg = model.CalculateAverage(value.WorshipService)
-		report.SundaySchoolAvg = model.CalculateAverage(value.SundaySchool)
-		report.PrayerMeetingsAvg = model.CalculateAverage(value.PrayerMeetings)
-		report.BibleStudiesAvg = model.CalculateAverage(value.BibleStudies)
-		report.MensFellowshipsAvg = model.CalculateAverage(value.MensFellowships)
-		report.WomensFellowshipsAvg = model.CalculateAverage(value.WomensFellowships)
-		report.YouthFellowshipsAvg = model.CalculateAverage(value.YouthFellowships)
-		report.ChildFellowshipsAvg = model.CalculateAverage(value.ChildFellowships)
-		report.OutreachAvg = model.CalculateAverage(value.Outreach)
-		report.TrainingOrSeminarsAvg = model.CalculateAverage(value.TrainingOrSeminars)
-		report.LeadershipConferencesAvg = model.CalculateAverage(value.LeadershipConferences)
-		report.LeadershipTrainingAvg = model.CalculateAverage(value.LeadershipTraining)
-		report.OthersAvg = model.CalculateAverage(value.Others)
-		report.FamilyDaysAvg = model.CalculateAverage(value.FamilyDays)
-		report.TithesAndOfferingsAvg = model.CalculateAverage(value.TithesAndOfferings)
-		report.HomeVisitedAvg = model.CalculateAverage(value.HomeVisited)
-		report.BibleStudyOrGroupLedAvg = model.CalculateAverage(value.BibleStudyOrGroupLed)
-		report.SermonOrMessagePreachedAvg = model.CalculateAverage(value.SermonOrMessagePreached)
-		report.PersonNewlyContactedAvg = model.CalculateAverage(value.PersonNewlyContacted)
-		report.PersonFollowedUpAvg = model.CalculateAverage(value.PersonFollowedUp)
-		report.PersonLedToChristAvg = model.CalculateAverage(value.PersonLedToChrist)
-
-		reportResp = append(reportResp, report)
-	}
-
-	return reportResp, nil
+	filter, err := applyReportListRequest(scope, request)
+	if err != nil {
+		return nil, pagination, err
+	}
+
+	total, err := r.reportRepository.Count(ctx, filter)
+	if err != nil {
+		return nil, pagination, err
+	}
+	pagination.Total = total
+	pagination.TotalPages = (total + limit - 1) / limit
+
+	reports, err := r.reportRepository.FindAll(ctx, filter)
+	if err != nil {
+		return nil, pagination, err // Return error if FindAll fails
+	}
+
+	reportResp := make([]response.ReportResponse, 0, len(reports))
+	for i := range reports {
+		reportResp = append(reportResp, r.toReportResponse(&reports[i]))
+	}
+
+	return reportResp, pagination, nil
 }
 
 func (r *ReportServiceImpl) FindById(ctx context.Context, reportId int) (response.ReportResponse, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	report, err := r.reportRepository.FindById(ctx, reportId)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	if !access.canView(report) {
+		return response.ReportResponse{}, ErrForbidden
+	}
+
+	return r.toReportResponse(report), nil
+}
+
+func (r *ReportServiceImpl) Update(ctx context.Context, request *request.ReportUpdateRequest) error {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return err
+	}
+
+	report, err := r.reportRepository.FindById(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+
+	if !access.canModify(report) {
+		return ErrForbidden
+	}
+	if report.Status.Locked() {
+		return ErrReportLocked
+	}
+
+	return r.applyUpdate(ctx, access, report, request)
+}
+
+// Patch applies an RFC 7396 merge patch to the report and returns the result.
+// Fields the patch does not mention keep their current values.
+func (r *ReportServiceImpl) Patch(ctx context.Context, reportId int, patch []byte) (response.ReportResponse, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
 	report, err := r.reportRepository.FindById(ctx, reportId)
 	if err != nil {
 		return response.ReportResponse{}, err
 	}
 
-	reportResp := response.ReportResponse{
+	if !access.canModify(report) {
+		return response.ReportResponse{}, ErrForbidden
+	}
+	if report.Status.Locked() {
+		return response.ReportResponse{}, ErrReportLocked
+	}
+
+	current, err := json.Marshal(toReportUpdateRequest(report))
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	merged, err := helper.MergePatch(current, patch)
+	if err != nil {
+		return response.ReportResponse{}, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	var patched request.ReportUpdateRequest
+	if err := json.Unmarshal(merged, &patched); err != nil {
+		return response.ReportResponse{}, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+	patched.Id = reportId
+
+	if err := patchValidator.Struct(&patched); err != nil {
+		return response.ReportResponse{}, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+
+	if err := r.applyUpdate(ctx, access, report, &patched); err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	updated, err := r.reportRepository.FindById(ctx, reportId)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	return r.toReportResponse(updated), nil
+}
+
+// applyUpdate copies every field of the request onto the report and saves it.
+func (r *ReportServiceImpl) applyUpdate(ctx context.Context, access *reportAccess, report *model.Report, request *request.ReportUpdateRequest) error {
+	var err error
+	report.MonthOf, err = model.ParsePeriod(request.MonthOf)
+	if err != nil {
+		return err
+	}
+	if request.WorkerId != report.WorkerId {
+		worker, err := r.workerRepository.FindById(ctx, request.WorkerId)
+		if err != nil {
+			return err
+		}
+		if access.user.Role == model.RoleWorker && worker.UserId != access.user.Id {
+			return ErrForbidden
+		}
+		report.WorkerId = worker.Id
+		report.WorkerName = worker.Name
+	}
+	if request.AreaId != 0 && request.AreaId != report.AreaId {
+		area, err := r.areaRepository.FindById(ctx, request.AreaId)
+		if err != nil {
+			return err
+		}
+		report.AreaId = area.Id
+		report.AreaOfAssignment = area.Name
+	}
+	if request.ChurchId != report.ChurchId {
+		church, err := r.churchRepository.FindById(ctx, request.ChurchId)
+		if err != nil {
+			return err
+		}
+		report.ChurchId = church.Id
+		report.NameOfChurch = church.Name
+	}
+	report.WorshipService = request.WorshipService
+	report.SundaySchool = request.SundaySchool
+	report.PrayerMeetings = request.PrayerMeetings
+	report.BibleStudies = request.BibleStudies
+	report.MensFellowships = request.MensFellowships
+	report.WomensFellowships = request.WomensFellowships
+	report.YouthFellowships = request.YouthFellowships
+	report.ChildFellowships = request.ChildFellowships
+	report.Outreach = request.Outreach
+	report.TrainingOrSeminars = request.TrainingOrSeminars
+	report.LeadershipConferences = request.LeadershipConferences
+	report.LeadershipTraining = request.LeadershipTraining
+	report.Others = request.Others
+	report.FamilyDays = request.FamilyDays
+	report.HomeVisited = request.HomeVisited
+	report.BibleStudyOrGroupLed = request.BibleStudyOrGroupLed
+	report.SermonOrMessagePreached = request.SermonOrMessagePreached
+	report.PersonNewlyContacted = request.PersonNewlyContacted
+	report.PersonFollowedUp = request.PersonFollowedUp
+	report.PersonLedToChrist = request.PersonLedToChrist
+	report.Names = request.Names
+	report.NarrativeReport = request.NarrativeReport
+	report.ChallengesAndProblemEncountered = request.ChallengesAndProblemEncountered
+	report.PrayerRequest = request.PrayerRequest
+	report.Finances = request.Finances
+
+	if err := dateEntries(report); err != nil {
+		return err
+	}
+	if err := checkFinances(report.Finances); err != nil {
+		return err
+	}
+	report.ComputeAverages(r.attendanceFormula)
+
+	if err := r.checkAverageAttendance(request.AverageAttendance, report.AverageAttendance); err != nil {
+		return err
+	}
+
+	// A supervisor may not move a report out of the area they oversee.
+	if !access.canModify(report) {
+		return ErrForbidden
+	}
+
+	err = r.reportRepository.Update(ctx, report)
+	if err != nil {
+		return r.asConflict(ctx, report, err)
+	}
+
+	return nil
+}
+
+// checkAverageAttendance compares a client-supplied average with the computed one.
+// Values within half a person are accepted because the server rounds to whole people.
+func (r *ReportServiceImpl) checkAverageAttendance(sent *float64, computed float64) error {
+	if sent == nil || math.Abs(*sent-computed) <= 0.5 {
+		return nil
+	}
+
+	if r.warnOnMismatch {
+		log.Warn().Float64("sent", *sent).Float64("computed", computed).Msg("ignoring client average_attendance")
+		return nil
+	}
+
+	return fmt.Errorf("%w: sent %v, computed %v", ErrAverageAttendanceMismatch, *sent, computed)
+}
+
+// asConflict turns a duplicate report error from the repository into a
+// ReportConflictError naming the report that already covers the period.
+func (r *ReportServiceImpl) asConflict(ctx context.Context, report *model.Report, err error) error {
+	if !errors.Is(err, repository.ErrReportDuplicate) {
+		return err
+	}
+
+	existingId, findErr := r.reportRepository.FindIdByPeriod(ctx, report.WorkerId, report.ChurchId, report.MonthOf)
+	if findErr != nil {
+		return err
+	}
+
+	return &ReportConflictError{ExistingReportId: existingId}
+}
+
+// resolveAreaId returns the requested area, or the church's area when none was given.
+func (r *ReportServiceImpl) resolveAreaId(ctx context.Context, areaId int, church *model.Church) (int, error) {
+	if areaId == 0 {
+		if church.AreaId == 0 {
+			return 0, ErrReportAreaRequired
+		}
+		return church.AreaId, nil
+	}
+
+	area, err := r.areaRepository.FindById(ctx, areaId)
+	if err != nil {
+		return 0, err
+	}
+
+	return area.Id, nil
+}
+
+// sessionsHeld counts, per activity, the weeks in which it actually took place.
+func sessionsHeld(stats map[string]model.Stats) map[string]int {
+	sessions := make(map[string]int, len(stats))
+	for key, activity := range stats {
+		sessions[key] = activity.Sessions
+	}
+	return sessions
+}
+
+func (r *ReportServiceImpl) toReportResponse(report *model.Report) response.ReportResponse {
+	stats := report.Stats(r.statsPrecision)
+
+	return response.ReportResponse{
 		Id:                              report.Id,
-		MonthOf:                         report.MonthOf,
+		UserId:                          report.UserId,
+		MonthOf:                         report.MonthOf.String(),
+		WorkerId:                        report.WorkerId,
 		WorkerName:                      report.WorkerName,
+		AreaId:                          report.AreaId,
 		AreaOfAssignment:                report.AreaOfAssignment,
+		ChurchId:                        report.ChurchId,
 		NameOfChurch:                    report.NameOfChurch,
+		Status:                          report.Status,
 		WorshipService:                  report.WorshipService,
 		SundaySchool:                    report.SundaySchool,
 		PrayerMeetings:                  report.PrayerMeetings,
@@ -185,7 +546,11 @@ func (r *ReportServiceImpl) FindById(ctx context.Context, reportId int) (respons
 		LeadershipTraining:              report.LeadershipTraining,
 		Others:                          report.Others,
 		FamilyDays:                      report.FamilyDays,
-		TithesAndOfferings:              report.TithesAndOfferings,
+		AverageAttendance:               report.AverageAttendance,
+		Finances:                        report.Finances,
+		FinancesTotal:                   report.Finances.Total(),
+		SessionsHeld:                    sessionsHeld(stats),
+		Stats:                           stats,
 		HomeVisited:                     report.HomeVisited,
 		BibleStudyOrGroupLed:            report.BibleStudyOrGroupLed,
 		SermonOrMessagePreached:         report.SermonOrMessagePreached,
@@ -196,50 +561,136 @@ func (r *ReportServiceImpl) FindById(ctx context.Context, reportId int) (respons
 		NarrativeReport:                 report.NarrativeReport,
 		ChallengesAndProblemEncountered: report.ChallengesAndProblemEncountered,
 		PrayerRequest:                   report.PrayerRequest,
+		WorshipServiceAvg:               report.WorshipServiceAvg,
+		SundaySchoolAvg:                 report.SundaySchoolAvg,
+		PrayerMeetingsAvg:               report.PrayerMeetingsAvg,
+		BibleStudiesAvg:                 report.BibleStudiesAvg,
+		MensFellowshipsAvg:              report.MensFellowshipsAvg,
+		WomensFellowshipsAvg:            report.WomensFellowshipsAvg,
+		YouthFellowshipsAvg:             report.YouthFellowshipsAvg,
+		ChildFellowshipsAvg:             report.ChildFellowshipsAvg,
+		OutreachAvg:                     report.OutreachAvg,
+		TrainingOrSeminarsAvg:           report.TrainingOrSeminarsAvg,
+		LeadershipConferencesAvg:        report.LeadershipConferencesAvg,
+		LeadershipTrainingAvg:           report.LeadershipTrainingAvg,
+		OthersAvg:                       report.OthersAvg,
+		FamilyDaysAvg:                   report.FamilyDaysAvg,
+		HomeVisitedAvg:                  report.HomeVisitedAvg,
+		BibleStudyOrGroupLedAvg:         report.BibleStudyOrGroupLedAvg,
+		SermonOrMessagePreachedAvg:      report.SermonOrMessagePreachedAvg,
+		PersonNewlyContactedAvg:         report.PersonNewlyContactedAvg,
+		PersonFollowedUpAvg:             report.PersonFollowedUpAvg,
+		PersonLedToChristAvg:            report.PersonLedToChristAvg,
 		CreatedAt:                       report.CreatedAt,
 		UpdatedAt:                       report.UpdatedAt,
 	}
+}
 
-	return reportResp, nil
+// toReportUpdateRequest is the inverse of applyUpdate, used as the document a merge patch is applied to.
+func toReportUpdateRequest(report *model.Report) request.ReportUpdateRequest {
+	return request.ReportUpdateRequest{
+		Id:                              report.Id,
+		MonthOf:                         report.MonthOf.String(),
+		WorkerId:                        report.WorkerId,
+		AreaId:                          report.AreaId,
+		ChurchId:                        report.ChurchId,
+		WorshipService:                  report.WorshipService,
+		SundaySchool:                    report.SundaySchool,
+		PrayerMeetings:                  report.PrayerMeetings,
+		BibleStudies:                    report.BibleStudies,
+		MensFellowships:                 report.MensFellowships,
+		WomensFellowships:               report.WomensFellowships,
+		YouthFellowships:                report.YouthFellowships,
+		ChildFellowships:                report.ChildFellowships,
+		Outreach:                        report.Outreach,
+		TrainingOrSeminars:              report.TrainingOrSeminars,
+		LeadershipConferences:           report.LeadershipConferences,
+		LeadershipTraining:              report.LeadershipTraining,
+		Others:                          report.Others,
+		FamilyDays:                      report.FamilyDays,
+		HomeVisited:                     report.HomeVisited,
+		BibleStudyOrGroupLed:            report.BibleStudyOrGroupLed,
+		SermonOrMessagePreached:         report.SermonOrMessagePreached,
+		PersonNewlyContacted:            report.PersonNewlyContacted,
+		PersonFollowedUp:                report.PersonFollowedUp,
+		PersonLedToChrist:               report.PersonLedToChrist,
+		Names:                           report.Names,
+		NarrativeReport:                 report.NarrativeReport,
+		ChallengesAndProblemEncountered: report.ChallengesAndProblemEncountered,
+		PrayerRequest:                   report.PrayerRequest,
+		Finances:                        report.Finances,
+	}
 }
 
-func (r *ReportServiceImpl) Update(ctx context.Context, request *request.ReportUpdateRequest) error {
-	report, err := r.reportRepository.FindById(ctx, request.Id)
-	if err != nil {
-		return err
+// checkFinances rejects negative amounts, unknown currency codes and amounts
+// in different currencies.
+func checkFinances(finances model.ReportFinances) error {
+	amounts := []struct {
+		field  string
+		amount model.Money
+	}{
+		{"finances.tithes", finances.Tithes},
+		{"finances.offerings", finances.Offerings},
+		{"finances.special_gifts", finances.SpecialGifts},
+	}
+	for _, a := range amounts {
+		if a.amount.Minor < 0 {
+			return &ValidationError{Field: a.field + ".amount", Reason: "min", Param: "0"}
+		}
+		// The column is CHAR(3), so anything but a known code would fail there.
+		if a.amount.Currency != "" && patchValidator.Var(a.amount.Currency, "iso4217") != nil {
+			return &ValidationError{Field: a.field + ".currency", Reason: "iso4217"}
+		}
 	}
 
-	report.MonthOf = request.MonthOf
-	report.WorkerName = request.WorkerName
-	report.AreaOfAssignment = request.AreaOfAssignment
-	report.NameOfChurch = request.NameOfChurch
-	report.WorshipService = request.WorshipService
-	report.SundaySchool = request.SundaySchool
-	report.PrayerMeetings = request.PrayerMeetings
-	report.BibleStudies = request.BibleStudies
-	report.MensFellowships = request.MensFellowships
-	report.WomensFellowships = request.WomensFellowships
-	report.YouthFellowships = request.YouthFellowships
-	report.ChildFellowships = request.ChildFellowships
-	report.Outreach = request.Outreach
-	report.TrainingOrSeminars = request.TrainingOrSeminars
-	report.LeadershipConferences = request.LeadershipConferences
-	report.LeadershipTraining = request.LeadershipTraining
-	report.Others = request.Others
-	report.FamilyDays = request.FamilyDays
-	report.TithesAndOfferings = request.TithesAndOfferings
-	report.HomeVisited = request.HomeVisited
-	report.BibleStudyOrGroupLed = request.BibleStudyOrGroupLed
-	report.SermonOrMessagePreached = request.SermonOrMessagePreached
-	report.PersonNewlyContacted = request.PersonNewlyContacted
-	report.PersonFollowedUp = request.PersonFollowedUp
-	report.NarrativeReport = request.NarrativeReport
-	report.ChallengesAndProblemEncountered = request.ChallengesAndProblemEncountered
-	report.PrayerRequest = request.PrayerRequest
+	if _, err := finances.Currency(); err != nil {
+		return &ValidationError{Field: "finances", Reason: "same_currency"}
+	}
+	return nil
+}
 
-	err = r.reportRepository.Update(ctx, report)
-	if err != nil {
-		return err
+// dateEntries places undated weekly counts on the dates Period.UndatedDates
+// gives for the report's month, in order, then checks that every entry falls
+// inside that month on a distinct date.
+func dateEntries(report *model.Report) error {
+	for _, activity := range report.Activities() {
+		entries := *activity.Entries
+		seen := map[string]bool{}
+
+		undated := 0
+		for _, entry := range entries {
+			if entry.Date == "" {
+				undated++
+			}
+		}
+		dates := report.MonthOf.UndatedDates(undated)
+		undated = 0
+
+		for i := range entries {
+			field := fmt.Sprintf("%s[%d].date", activity.Key, i)
+
+			if entries[i].Date == "" {
+				if undated >= len(dates) {
+					return &ValidationError{Field: field, Reason: "required"}
+				}
+				entries[i].Date = dates[undated].Format(model.DateLayout)
+				undated++
+			}
+
+			date, err := time.Parse(model.DateLayout, entries[i].Date)
+			if err != nil {
+				return &ValidationError{Field: field, Reason: "datetime", Param: model.DateLayout}
+			}
+			if !report.MonthOf.Contains(date) {
+				return &ValidationError{Field: field, Reason: "in_month", Param: report.MonthOf.String()}
+			}
+			if seen[entries[i].Date] {
+				return &ValidationError{Field: field, Reason: "unique"}
+			}
+			seen[entries[i].Date] = true
+		}
+
+		entries.SortByDate()
 	}
 
 	return nil
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"reports/model"
+	"testing"
+	"time"
+)
+
+func counts(values ...int) model.WeeklyEntries {
+	entries := make(model.WeeklyEntries, len(values))
+	for i := range values {
+		entries[i] = model.WeeklyEntry{Count: &values[i]}
+	}
+	return entries
+}
+
+func entryDates(entries model.WeeklyEntries) []string {
+	dates := make([]string, len(entries))
+	for i, entry := range entries {
+		dates[i] = entry.Date
+	}
+	return dates
+}
+
+func TestDateEntries(t *testing.T) {
+	tests := []struct {
+		name       string
+		period     model.Period
+		entries    model.WeeklyEntries
+		wantDates  []string
+		wantField  string
+		wantReason string
+	}{
+		{
+			name:      "undated counts go on Sundays",
+			period:    model.NewPeriod(2024, time.March),
+			entries:   counts(30, 28, 35),
+			wantDates: []string{"2024-03-03", "2024-03-10", "2024-03-17"},
+		},
+		{
+			name:      "six undated counts in February",
+			period:    model.NewPeriod(2026, time.February),
+			entries:   counts(1, 2, 3, 4, 5, 6),
+			wantDates: []string{"2026-02-01", "2026-02-08", "2026-02-15", "2026-02-22", "2026-02-27", "2026-02-28"},
+		},
+		{
+			name:   "dated entries are sorted",
+			period: model.NewPeriod(2024, time.March),
+			entries: model.WeeklyEntries{
+				{Date: "2024-03-20", Count: counts(2)[0].Count},
+				{Date: "2024-03-06", Count: counts(1)[0].Count},
+			},
+			wantDates: []string{"2024-03-06", "2024-03-20"},
+		},
+		{
+			name:       "date outside the month",
+			period:     model.NewPeriod(2024, time.March),
+			entries:    model.WeeklyEntries{{Date: "2024-04-07", Count: counts(1)[0].Count}},
+			wantField:  "worship_service[0].date",
+			wantReason: "in_month",
+		},
+		{
+			name:   "duplicate date",
+			period: model.NewPeriod(2024, time.March),
+			entries: model.WeeklyEntries{
+				{Date: "2024-03-03", Count: counts(1)[0].Count},
+				{Count: counts(2)[0].Count},
+			},
+			wantField:  "worship_service[1].date",
+			wantReason: "unique",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &model.Report{MonthOf: tt.period, WorshipService: tt.entries}
+			err := dateEntries(report)
+
+			if tt.wantReason != "" {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField || validationErr.Reason != tt.wantReason {
+					t.Fatalf("dateEntries() error = %v, want %s on %s", err, tt.wantReason, tt.wantField)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("dateEntries() error = %v", err)
+			}
+			got := entryDates(report.WorshipService)
+			if len(got) != len(tt.wantDates) {
+				t.Fatalf("dates = %v, want %v", got, tt.wantDates)
+			}
+			for i := range got {
+				if got[i] != tt.wantDates[i] {
+					t.Fatalf("dates = %v, want %v", got, tt.wantDates)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckFinances(t *testing.T) {
+	tests := []struct {
+		name     string
+		finances model.ReportFinances
+		field    string
+	}{
+		{"valid", model.ReportFinances{Tithes: model.NewMoney(1000, "PHP"), Offerings: model.NewMoney(500, "")}, ""},
+		{"negative", model.ReportFinances{Offerings: model.NewMoney(-1, "PHP")}, "finances.offerings.amount"},
+		{"unknown currency", model.ReportFinances{SpecialGifts: model.Money{Minor: 100, Currency: "XYZ"}}, "finances.special_gifts.currency"},
+		{"mixed currencies", model.ReportFinances{Tithes: model.NewMoney(100, "PHP"), Offerings: model.NewMoney(100, "USD")}, "finances"},
+	}
+
+	for _, tt := range tests {
+		err := checkFinances(tt.finances)
+		var validationErr *ValidationError
+		switch {
+		case tt.field == "" && err != nil:
+			t.Errorf("%s: checkFinances() = %v, want nil", tt.name, err)
+		case tt.field != "" && (!errors.As(err, &validationErr) || validationErr.Field != tt.field):
+			t.Errorf("%s: checkFinances() = %v, want a validation error on %s", tt.name, err, tt.field)
+		}
+	}
+}
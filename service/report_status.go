@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"strings"
+)
+
+var (
+	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrReportLocked      = repository.ErrReportLocked
+)
+
+// Transition implements ReportService. It checks the action against the
+// report's current status and the user's role, then records who took it.
+func (r *ReportServiceImpl) Transition(ctx context.Context, reportId int, action model.ReportAction, note string) (response.ReportResponse, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	report, err := r.reportRepository.FindById(ctx, reportId)
+	if err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	if !access.canView(report) || !access.canTransition(report, action) {
+		return response.ReportResponse{}, ErrForbidden
+	}
+
+	next, ok := action.Next(report.Status)
+	if !ok {
+		return response.ReportResponse{}, fmt.Errorf("%w: cannot %s a report that is %s", ErrInvalidTransition, action, report.Status)
+	}
+
+	note = strings.TrimSpace(note)
+	if note == "" && action.NeedsNote() {
+		return response.ReportResponse{}, &ValidationError{Field: "note", Reason: "required"}
+	}
+
+	change := &model.ReportStatusChange{
+		ReportId: report.Id,
+		Action:   action,
+		From:     report.Status,
+		To:       next,
+		ActorId:  access.user.Id,
+		Note:     note,
+	}
+	if err := r.reportRepository.ChangeStatus(ctx, change); err != nil {
+		return response.ReportResponse{}, err
+	}
+
+	report.Status = next
+	return r.toReportResponse(report), nil
+}
+
+// History implements ReportService.
+func (r *ReportServiceImpl) History(ctx context.Context, reportId int) ([]response.ReportStatusChangeResponse, error) {
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := r.reportRepository.FindById(ctx, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.canView(report) {
+		return nil, ErrForbidden
+	}
+
+	changes, err := r.reportRepository.FindStatusChanges(ctx, report.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]response.ReportStatusChangeResponse, len(changes))
+	for i, change := range changes {
+		history[i] = response.ReportStatusChangeResponse{
+			Id:        change.Id,
+			Action:    change.Action,
+			From:      change.From,
+			To:        change.To,
+			ActorId:   change.ActorId,
+			ActorName: change.ActorName,
+			Note:      change.Note,
+			ChangedAt: change.ChangedAt,
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type ReportSummaryService interface {
+	Finances(ctx context.Context, request *request.FinanceSummaryRequest) ([]response.FinanceSummaryResponse, error)
+	Area(ctx context.Context, areaId int, request *request.AreaSummaryRequest) (response.AreaSummaryResponse, error)
+	Trend(ctx context.Context, request *request.TrendRequest) (response.TrendResponse, error)
+}
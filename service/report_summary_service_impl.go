@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+// maxTrendMonths bounds the range of a trend.
+const maxTrendMonths = 120
+
+type ReportSummaryServiceImpl struct {
+	reportSummaryRepository repository.ReportSummaryRepository
+	areaRepository          repository.AreaRepository
+}
+
+func NewReportSummaryServiceImpl(reportSummaryRepository repository.ReportSummaryRepository, areaRepository repository.AreaRepository) ReportSummaryService {
+	return &ReportSummaryServiceImpl{
+		reportSummaryRepository: reportSummaryRepository,
+		areaRepository:          areaRepository,
+	}
+}
+
+// Finances implements ReportSummaryService. Only the reports the user may
+// read are summed.
+func (r *ReportSummaryServiceImpl) Finances(ctx context.Context, request *request.FinanceSummaryRequest) ([]response.FinanceSummaryResponse, error) {
+	grouping, err := model.ParseFinanceGrouping(request.GroupBy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := access.scope()
+	if !ok {
+		return []response.FinanceSummaryResponse{}, nil
+	}
+
+	filter.AreaId = request.AreaId
+	filter.WorkerId = request.WorkerId
+	filter.ChurchId = request.ChurchId
+	if filter.MonthFrom, err = parsePeriodParam(request.MonthFrom); err != nil {
+		return nil, err
+	}
+	if filter.MonthTo, err = parsePeriodParam(request.MonthTo); err != nil {
+		return nil, err
+	}
+
+	summaries, err := r.reportSummaryRepository.Finances(ctx, filter, grouping)
+	if err != nil {
+		return nil, err
+	}
+
+	summaryResp := make([]response.FinanceSummaryResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		summaryResp = append(summaryResp, response.FinanceSummaryResponse{
+			GroupId:       summary.GroupId,
+			GroupName:     summary.GroupName,
+			MonthOf:       summary.MonthOf,
+			Reports:       summary.Reports,
+			Tithes:        summary.Tithes,
+			Offerings:     summary.Offerings,
+			SpecialGifts:  summary.SpecialGifts,
+			Total:         summary.Total,
+			Change:        summary.Change,
+			ChangePercent: summary.ChangePercent,
+			YearToDate:    summary.YearToDate,
+		})
+	}
+
+	return summaryResp, nil
+}
+
+// Area implements ReportSummaryService.
+func (r *ReportSummaryServiceImpl) Area(ctx context.Context, areaId int, request *request.AreaSummaryRequest) (response.AreaSummaryResponse, error) {
+	period, err := parsePeriodParam(request.Month)
+	if err != nil {
+		return response.AreaSummaryResponse{}, err
+	}
+
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return response.AreaSummaryResponse{}, err
+	}
+
+	if !access.canViewArea(areaId) {
+		return response.AreaSummaryResponse{}, ErrForbidden
+	}
+
+	area, err := r.areaRepository.FindById(ctx, areaId)
+	if err != nil {
+		return response.AreaSummaryResponse{}, err
+	}
+
+	churches, err := r.reportSummaryRepository.AreaChurches(ctx, areaId, period)
+	if err != nil {
+		return response.AreaSummaryResponse{}, err
+	}
+
+	summary := model.AreaSummary{Area: *area, MonthOf: period, Churches: churches}
+
+	churchResp := make([]response.ChurchSummaryResponse, 0, len(churches))
+	for _, church := range churches {
+		churchResp = append(churchResp, response.ChurchSummaryResponse{
+			ChurchId:          church.ChurchId,
+			ChurchName:        church.ChurchName,
+			Expected:          church.Expected,
+			Reports:           church.Reports,
+			AverageAttendance: church.AverageAttendance,
+			Activities:        church.Activities,
+		})
+	}
+
+	return response.AreaSummaryResponse{
+		AreaId:            area.Id,
+		AreaName:          area.Name,
+		MonthOf:           summary.MonthOf,
+		ReportsReceived:   summary.ReportsReceived(),
+		ReportsExpected:   summary.ReportsExpected(),
+		ChurchesMissing:   summary.ChurchesMissing(),
+		AverageAttendance: summary.AverageAttendance(),
+		Activities:        summary.Activities(),
+		Churches:          churchResp,
+	}, nil
+}
+
+// Trend implements ReportSummaryService. Only the reports the user may read
+// are counted.
+func (r *ReportSummaryServiceImpl) Trend(ctx context.Context, request *request.TrendRequest) (response.TrendResponse, error) {
+	if !model.IsTrendMetric(request.Metric) {
+		return response.TrendResponse{}, fmt.Errorf("%w: unknown metric %q", ErrInvalidFilter, request.Metric)
+	}
+
+	monthFrom, monthTo, err := trendRange(request.MonthFrom, request.MonthTo)
+	if err != nil {
+		return response.TrendResponse{}, err
+	}
+
+	trendResp := response.TrendResponse{
+		Metric:    request.Metric,
+		MonthFrom: monthFrom,
+		MonthTo:   monthTo,
+		Points:    []response.TrendPointResponse{},
+	}
+
+	access, err := loadReportAccess(ctx, r.areaRepository)
+	if err != nil {
+		return response.TrendResponse{}, err
+	}
+
+	filter, ok := access.scope()
+	if !ok {
+		return trendResp, nil
+	}
+
+	filter.AreaId = request.AreaId
+	filter.WorkerId = request.WorkerId
+	filter.ChurchId = request.ChurchId
+	filter.MonthFrom = monthFrom
+	filter.MonthTo = monthTo
+
+	points, err := r.reportSummaryRepository.Trend(ctx, filter, request.Metric)
+	if err != nil {
+		return response.TrendResponse{}, err
+	}
+
+	for _, point := range points {
+		trendResp.Points = append(trendResp.Points, response.TrendPointResponse{
+			MonthOf:        point.MonthOf,
+			Reports:        point.Reports,
+			Value:          point.Value,
+			MonthOverMonth: model.DeltaOf(point.PreviousMonth, point.Value),
+			YearOverYear:   model.DeltaOf(point.PreviousYear, point.Value),
+		})
+	}
+
+	return trendResp, nil
+}
+
+// trendRange parses the months of a trend, defaulting to the twelve months
+// ending with the current one in Manila.
+func trendRange(from string, to string) (model.Period, model.Period, error) {
+	monthFrom, err := parsePeriodParam(from)
+	if err != nil {
+		return model.Period{}, model.Period{}, err
+	}
+	monthTo, err := parsePeriodParam(to)
+	if err != nil {
+		return model.Period{}, model.Period{}, err
+	}
+
+	if monthTo.IsZero() {
+		loc, err := time.LoadLocation("Asia/Manila")
+		if err != nil {
+			return model.Period{}, model.Period{}, err
+		}
+		monthTo = model.PeriodOf(time.Now().In(loc))
+	}
+	if monthFrom.IsZero() {
+		monthFrom = monthTo.AddMonths(-11)
+	}
+
+	if monthTo.Before(monthFrom) {
+		return model.Period{}, model.Period{}, fmt.Errorf("%w: month_to is before month_from", ErrInvalidFilter)
+	}
+	if monthFrom.AddMonths(maxTrendMonths).Before(monthTo.AddMonths(1)) {
+		return model.Period{}, model.Period{}, fmt.Errorf("%w: a trend may cover at most %d months", ErrInvalidFilter, maxTrendMonths)
+	}
+
+	return monthFrom, monthTo, nil
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type UserService interface {
+	Create(ctx context.Context, request *request.UserCreateRequest) (response.UserResponse, error)
+	Update(ctx context.Context, request *request.UserUpdateRequest) error
+	Delete(ctx context.Context, userId int) error
+	FindById(ctx context.Context, userId int) (response.UserResponse, error)
+	FindAll(ctx context.Context) ([]response.UserResponse, error)
+	ChangePassword(ctx context.Context, userId int, request *request.PasswordChangeRequest) error
+	ResetPassword(ctx context.Context, userId int, request *request.PasswordResetRequest) error
+}
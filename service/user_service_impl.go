@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/helper"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+var (
+	ErrUsernameTaken        = errors.New("username is already taken")
+	ErrWrongCurrentPassword = errors.New("current password is incorrect")
+)
+
+type UserServiceImpl struct {
+	userRepository repository.UserRepository
+	areaRepository repository.AreaRepository
+}
+
+func NewUserServiceImpl(userRepository repository.UserRepository, areaRepository repository.AreaRepository) UserService {
+	return &UserServiceImpl{userRepository: userRepository, areaRepository: areaRepository}
+}
+
+func (u *UserServiceImpl) Create(ctx context.Context, request *request.UserCreateRequest) (response.UserResponse, error) {
+	_, err := u.userRepository.FindByUsername(ctx, request.Username)
+	if err == nil {
+		return response.UserResponse{}, ErrUsernameTaken
+	}
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		return response.UserResponse{}, err
+	}
+
+	if request.AreaId != 0 {
+		if _, err := u.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return response.UserResponse{}, err
+		}
+	}
+
+	hashedPassword, err := helper.HashPassword(request.Password)
+	if err != nil {
+		return response.UserResponse{}, err
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return response.UserResponse{}, err
+	}
+
+	now := time.Now().In(loc)
+
+	user := model.User{
+		Username:  request.Username,
+		Name:      request.Name,
+		Email:     request.Email,
+		Password:  hashedPassword,
+		Role:      model.Role(request.Role),
+		AreaId:    request.AreaId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err = u.userRepository.Save(ctx, &user)
+	if err != nil {
+		return response.UserResponse{}, fmt.Errorf("failed to save user: %w", err)
+	}
+
+	return toUserResponse(&user), nil
+}
+
+func (u *UserServiceImpl) Update(ctx context.Context, request *request.UserUpdateRequest) error {
+	user, err := u.userRepository.FindById(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+
+	if request.AreaId != 0 {
+		if _, err := u.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return err
+		}
+	}
+
+	user.Name = request.Name
+	user.Email = request.Email
+	user.Role = model.Role(request.Role)
+	user.AreaId = request.AreaId
+
+	return u.userRepository.Update(ctx, user)
+}
+
+func (u *UserServiceImpl) Delete(ctx context.Context, userId int) error {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	return u.userRepository.Delete(ctx, user.Id)
+}
+
+func (u *UserServiceImpl) FindById(ctx context.Context, userId int) (response.UserResponse, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return response.UserResponse{}, err
+	}
+
+	return toUserResponse(user), nil
+}
+
+func (u *UserServiceImpl) FindAll(ctx context.Context) ([]response.UserResponse, error) {
+	users, err := u.userRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var userResp []response.UserResponse
+	for i := range users {
+		userResp = append(userResp, toUserResponse(&users[i]))
+	}
+
+	return userResp, nil
+}
+
+// ChangePassword lets a user replace their own password after proving they know the current one.
+func (u *UserServiceImpl) ChangePassword(ctx context.Context, userId int, request *request.PasswordChangeRequest) error {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if err := helper.VerifyPassword(user.Password, request.CurrentPassword); err != nil {
+		return ErrWrongCurrentPassword
+	}
+
+	hashedPassword, err := helper.HashPassword(request.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	return u.userRepository.UpdatePassword(ctx, user.Id, hashedPassword)
+}
+
+// ResetPassword sets a new password for any user without checking the old one.
+func (u *UserServiceImpl) ResetPassword(ctx context.Context, userId int, request *request.PasswordResetRequest) error {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := helper.HashPassword(request.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	return u.userRepository.UpdatePassword(ctx, user.Id, hashedPassword)
+}
+
+func toUserResponse(user *model.User) response.UserResponse {
+	return response.UserResponse{
+		Id:        user.Id,
+		Username:  user.Username,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      string(user.Role),
+		AreaId:    user.AreaId,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package service
+
+import "fmt"
+
+// ValidationError reports a request field rejected by a rule the binding tags
+// cannot express, such as one that depends on another field.
+type ValidationError struct {
+	Field  string
+	Reason string
+	Param  string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Param == "" {
+		return fmt.Sprintf("%s failed %s validation", e.Field, e.Reason)
+	}
+	return fmt.Sprintf("%s failed %s=%s validation", e.Field, e.Reason, e.Param)
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+	"reports/data/request"
+	"reports/data/response"
+)
+
+type WorkerService interface {
+	Create(ctx context.Context, request *request.WorkerCreateRequest) (response.WorkerResponse, error)
+	Update(ctx context.Context, request *request.WorkerUpdateRequest) error
+	Delete(ctx context.Context, workerId int) error
+	FindById(ctx context.Context, workerId int) (response.WorkerResponse, error)
+	FindAll(ctx context.Context, request *request.WorkerListRequest) ([]response.WorkerResponse, error)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reports/data/request"
+	"reports/data/response"
+	"reports/model"
+	"reports/repository"
+	"time"
+)
+
+type WorkerServiceImpl struct {
+	workerRepository repository.WorkerRepository
+	userRepository   repository.UserRepository
+	areaRepository   repository.AreaRepository
+}
+
+func NewWorkerServiceImpl(workerRepository repository.WorkerRepository, userRepository repository.UserRepository, areaRepository repository.AreaRepository) WorkerService {
+	return &WorkerServiceImpl{
+		workerRepository: workerRepository,
+		userRepository:   userRepository,
+		areaRepository:   areaRepository,
+	}
+}
+
+func (w *WorkerServiceImpl) Create(ctx context.Context, request *request.WorkerCreateRequest) (response.WorkerResponse, error) {
+	if request.UserId != 0 {
+		if _, err := w.userRepository.FindById(ctx, request.UserId); err != nil {
+			return response.WorkerResponse{}, err
+		}
+	}
+
+	if request.AreaId != 0 {
+		if _, err := w.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return response.WorkerResponse{}, err
+		}
+	}
+
+	loc, err := time.LoadLocation("Asia/Manila")
+	if err != nil {
+		return response.WorkerResponse{}, err
+	}
+
+	now := time.Now().In(loc)
+
+	status := model.WorkerStatus(request.Status)
+	if status == "" {
+		status = model.WorkerStatusActive
+	}
+
+	worker := model.Worker{
+		Name:          request.Name,
+		ContactNumber: request.ContactNumber,
+		Email:         request.Email,
+		Status:        status,
+		UserId:        request.UserId,
+		AreaId:        request.AreaId,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err = w.workerRepository.Save(ctx, &worker)
+	if err != nil {
+		return response.WorkerResponse{}, fmt.Errorf("failed to save worker: %w", err)
+	}
+
+	return toWorkerResponse(&worker), nil
+}
+
+func (w *WorkerServiceImpl) Update(ctx context.Context, request *request.WorkerUpdateRequest) error {
+	worker, err := w.workerRepository.FindById(ctx, request.Id)
+	if err != nil {
+		return err
+	}
+
+	if request.UserId != 0 {
+		if _, err := w.userRepository.FindById(ctx, request.UserId); err != nil {
+			return err
+		}
+	}
+
+	if request.AreaId != 0 {
+		if _, err := w.areaRepository.FindById(ctx, request.AreaId); err != nil {
+			return err
+		}
+	}
+
+	worker.Name = request.Name
+	worker.ContactNumber = request.ContactNumber
+	worker.Email = request.Email
+	worker.Status = model.WorkerStatus(request.Status)
+	worker.UserId = request.UserId
+	worker.AreaId = request.AreaId
+
+	return w.workerRepository.Update(ctx, worker)
+}
+
+func (w *WorkerServiceImpl) Delete(ctx context.Context, workerId int) error {
+	worker, err := w.workerRepository.FindById(ctx, workerId)
+	if err != nil {
+		return err
+	}
+
+	return w.workerRepository.Delete(ctx, worker.Id)
+}
+
+func (w *WorkerServiceImpl) FindById(ctx context.Context, workerId int) (response.WorkerResponse, error) {
+	worker, err := w.workerRepository.FindById(ctx, workerId)
+	if err != nil {
+		return response.WorkerResponse{}, err
+	}
+
+	return toWorkerResponse(worker), nil
+}
+
+func (w *WorkerServiceImpl) FindAll(ctx context.Context, request *request.WorkerListRequest) ([]response.WorkerResponse, error) {
+	workers, err := w.workerRepository.FindAll(ctx, model.WorkerFilter{AreaId: request.AreaId})
+	if err != nil {
+		return nil, err
+	}
+
+	var workerResp []response.WorkerResponse
+	for i := range workers {
+		workerResp = append(workerResp, toWorkerResponse(&workers[i]))
+	}
+
+	return workerResp, nil
+}
+
+func toWorkerResponse(worker *model.Worker) response.WorkerResponse {
+	return response.WorkerResponse{
+		Id:            worker.Id,
+		Name:          worker.Name,
+		ContactNumber: worker.ContactNumber,
+		Email:         worker.Email,
+		Status:        string(worker.Status),
+		UserId:        worker.UserId,
+		AreaId:        worker.AreaId,
+		CreatedAt:     worker.CreatedAt,
+		UpdatedAt:     worker.UpdatedAt,
+	}
+}
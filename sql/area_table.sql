@@ -0,0 +1,21 @@
+CREATE TABLE areas (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    level VARCHAR(20) NOT NULL CHECK (level IN ('region', 'district', 'area')),
+    parent_id INT REFERENCES areas (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (parent_id, name)
+);
+
+CREATE INDEX areas_parent_id_idx ON areas (parent_id);
+
+-- Returns the given area and every area below it.
+CREATE FUNCTION area_subtree(root_id INT) RETURNS TABLE (id INT) AS $$
+    WITH RECURSIVE subtree AS (
+        SELECT a.id FROM areas a WHERE a.id = root_id
+        UNION ALL
+        SELECT a.id FROM areas a JOIN subtree s ON a.parent_id = s.id
+    )
+    SELECT subtree.id FROM subtree
+$$ LANGUAGE SQL STABLE;
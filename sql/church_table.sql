@@ -0,0 +1,13 @@
+CREATE TABLE churches (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255),
+    barangay VARCHAR(100),
+    municipality VARCHAR(100),
+    province VARCHAR(100),
+    date_organized DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'preaching_point' CHECK (status IN ('preaching_point', 'organized_church')),
+    area_id INT REFERENCES areas (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
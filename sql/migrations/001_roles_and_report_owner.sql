@@ -0,0 +1,12 @@
+-- Adds roles to users and records which user owns each report.
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'worker' CHECK (role IN ('worker', 'area_supervisor', 'admin', 'auditor')),
+    ADD COLUMN area_of_assignment VARCHAR(100);
+
+UPDATE users SET role = 'admin' WHERE username = 'admin';
+
+ALTER TABLE reports
+    ADD COLUMN user_id INT REFERENCES users (id) ON DELETE SET NULL;
+
+CREATE INDEX reports_user_id_idx ON reports (user_id);
+CREATE INDEX reports_area_of_assignment_idx ON reports (area_of_assignment);
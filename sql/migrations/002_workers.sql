@@ -0,0 +1,57 @@
+-- Moves reports.worker_name into a workers table and points every report at a worker record.
+BEGIN;
+
+CREATE TABLE workers (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    contact_number VARCHAR(50),
+    email VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive')),
+    user_id INT UNIQUE REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Names are matched ignoring case, repeated spaces, punctuation and titles,
+-- so "Ptr. Juan Dela Cruz" and "juan  dela cruz" become one worker.
+CREATE FUNCTION pg_temp.worker_name_key(name TEXT) RETURNS TEXT AS $$
+    SELECT trim(regexp_replace(
+        regexp_replace(
+            lower(trim(name)),
+            '^((ptr|pastor|ps|rev|reverend|bro|brother|sis|sister|evang|evangelist)\.?\s+)+', ''
+        ),
+        '[^a-z0-9]+', ' ', 'g'
+    ))
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- The most frequently used spelling of each name becomes the worker's name.
+INSERT INTO workers (name)
+SELECT DISTINCT ON (name_key) worker_name
+FROM (
+    SELECT worker_name, pg_temp.worker_name_key(worker_name) AS name_key, count(*) AS uses
+    FROM reports
+    GROUP BY worker_name
+) spellings
+ORDER BY name_key, uses DESC, worker_name;
+
+ALTER TABLE reports ADD COLUMN worker_id INT REFERENCES workers (id);
+
+UPDATE reports r
+SET worker_id = w.id
+FROM workers w
+WHERE pg_temp.worker_name_key(w.name) = pg_temp.worker_name_key(r.worker_name);
+
+-- Lists the merges so they can be reviewed after the migration.
+SELECT w.id AS worker_id, w.name AS worker_name, array_agg(DISTINCT r.worker_name) AS merged_spellings
+FROM workers w
+JOIN reports r ON r.worker_id = w.id
+GROUP BY w.id, w.name
+HAVING count(DISTINCT r.worker_name) > 1
+ORDER BY w.name;
+
+ALTER TABLE reports ALTER COLUMN worker_id SET NOT NULL;
+ALTER TABLE reports DROP COLUMN worker_name;
+
+CREATE INDEX reports_worker_id_idx ON reports (worker_id);
+
+COMMIT;
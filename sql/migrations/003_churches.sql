@@ -0,0 +1,45 @@
+-- Moves reports.name_of_church into a churches table and points every report at a church record.
+BEGIN;
+
+CREATE TABLE churches (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255),
+    barangay VARCHAR(100),
+    municipality VARCHAR(100),
+    province VARCHAR(100),
+    date_organized DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'preaching_point' CHECK (status IN ('preaching_point', 'organized_church')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Names are matched ignoring case, punctuation and repeated spaces.
+CREATE FUNCTION pg_temp.church_name_key(name TEXT) RETURNS TEXT AS $$
+    SELECT trim(regexp_replace(lower(name), '[^a-z0-9]+', ' ', 'g'))
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- The most frequently used spelling of each name becomes the church's name.
+-- Address and status are unknown for migrated churches and must be filled in afterwards.
+INSERT INTO churches (name)
+SELECT DISTINCT ON (name_key) name_of_church
+FROM (
+    SELECT name_of_church, pg_temp.church_name_key(name_of_church) AS name_key, count(*) AS uses
+    FROM reports
+    GROUP BY name_of_church
+) spellings
+ORDER BY name_key, uses DESC, name_of_church;
+
+ALTER TABLE reports ADD COLUMN church_id INT REFERENCES churches (id);
+
+UPDATE reports r
+SET church_id = c.id
+FROM churches c
+WHERE pg_temp.church_name_key(c.name) = pg_temp.church_name_key(r.name_of_church);
+
+ALTER TABLE reports ALTER COLUMN church_id SET NOT NULL;
+ALTER TABLE reports DROP COLUMN name_of_church;
+
+CREATE INDEX reports_church_id_idx ON reports (church_id);
+
+COMMIT;
@@ -0,0 +1,71 @@
+-- Replaces the flat area_of_assignment strings with an areas tree (region > district > area)
+-- and assigns reports, users, churches and workers to areas.
+BEGIN;
+
+CREATE TABLE areas (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    level VARCHAR(20) NOT NULL CHECK (level IN ('region', 'district', 'area')),
+    parent_id INT REFERENCES areas (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (parent_id, name)
+);
+
+CREATE INDEX areas_parent_id_idx ON areas (parent_id);
+
+CREATE FUNCTION area_subtree(root_id INT) RETURNS TABLE (id INT) AS $$
+    WITH RECURSIVE subtree AS (
+        SELECT a.id FROM areas a WHERE a.id = root_id
+        UNION ALL
+        SELECT a.id FROM areas a JOIN subtree s ON a.parent_id = s.id
+    )
+    SELECT subtree.id FROM subtree
+$$ LANGUAGE SQL STABLE;
+
+-- Every distinct existing value becomes an area under a placeholder "Unassigned"
+-- district and region, so each legacy area has the district parent the API
+-- requires. Reports with a blank area_of_assignment go to an "Unassigned" area
+-- there. An administrator then creates the real regions and districts and moves
+-- these areas under them through PUT /api/areas/:areaId.
+INSERT INTO areas (name, level) VALUES ('Unassigned', 'region');
+
+INSERT INTO areas (name, level, parent_id)
+SELECT 'Unassigned', 'district', id FROM areas WHERE level = 'region' AND name = 'Unassigned';
+
+INSERT INTO areas (name, level, parent_id)
+SELECT DISTINCT COALESCE(NULLIF(trim(existing.area_of_assignment), ''), 'Unassigned'), 'area', district.id
+FROM (
+    SELECT area_of_assignment FROM reports
+    UNION
+    SELECT area_of_assignment FROM users WHERE trim(area_of_assignment) <> ''
+) existing, areas district
+WHERE district.level = 'district' AND district.name = 'Unassigned';
+
+ALTER TABLE reports ADD COLUMN area_id INT REFERENCES areas (id);
+UPDATE reports r SET area_id = a.id
+FROM areas a
+WHERE a.level = 'area' AND a.name = COALESCE(NULLIF(trim(r.area_of_assignment), ''), 'Unassigned');
+ALTER TABLE reports ALTER COLUMN area_id SET NOT NULL;
+DROP INDEX reports_area_of_assignment_idx;
+ALTER TABLE reports DROP COLUMN area_of_assignment;
+CREATE INDEX reports_area_id_idx ON reports (area_id);
+
+ALTER TABLE users ADD COLUMN area_id INT REFERENCES areas (id);
+UPDATE users u SET area_id = a.id FROM areas a WHERE a.level = 'area' AND a.name = trim(u.area_of_assignment);
+ALTER TABLE users DROP COLUMN area_of_assignment;
+
+-- Churches and workers take the area they most often reported from.
+ALTER TABLE churches ADD COLUMN area_id INT REFERENCES areas (id);
+UPDATE churches c SET area_id = (
+    SELECT r.area_id FROM reports r WHERE r.church_id = c.id
+    GROUP BY r.area_id ORDER BY count(*) DESC, r.area_id LIMIT 1
+);
+
+ALTER TABLE workers ADD COLUMN area_id INT REFERENCES areas (id);
+UPDATE workers w SET area_id = (
+    SELECT r.area_id FROM reports r WHERE r.worker_id = w.id
+    GROUP BY r.area_id ORDER BY count(*) DESC, r.area_id LIMIT 1
+);
+
+COMMIT;
@@ -0,0 +1,93 @@
+-- Replaces the free-text reports.month_of with a DATE holding the first day of the reported month.
+BEGIN;
+
+-- Understands the spellings found in existing reports: "2024-03", "2024/3", "2024-03-15",
+-- "03/2024", "March 2024", "Mar. 2024", "march, 2024" and "2024 March".
+-- Returns NULL for anything else, including a month without a year such as "Mar".
+CREATE FUNCTION pg_temp.parse_month_of(value TEXT) RETURNS DATE AS $$
+DECLARE
+    normalized TEXT := regexp_replace(lower(trim(value)), '[\s,.]+', ' ', 'g');
+    parts TEXT[];
+    month_names TEXT[] := ARRAY['january', 'february', 'march', 'april', 'may', 'june', 'july',
+                                'august', 'september', 'october', 'november', 'december'];
+BEGIN
+    parts := regexp_match(normalized, '^(\d{4})[-/](\d{1,2})([-/]\d{1,2})?$');
+    IF parts IS NOT NULL THEN
+        RETURN make_date(parts[1]::INT, parts[2]::INT, 1);
+    END IF;
+
+    parts := regexp_match(normalized, '^(\d{1,2})[-/](\d{4})$');
+    IF parts IS NOT NULL THEN
+        RETURN make_date(parts[2]::INT, parts[1]::INT, 1);
+    END IF;
+
+    parts := regexp_match(normalized, '^([a-z]{3,}) (\d{4})$');
+    IF parts IS NULL THEN
+        parts := regexp_match(normalized, '^(\d{4}) ([a-z]{3,})$');
+        IF parts IS NOT NULL THEN
+            parts := ARRAY[parts[2], parts[1]];
+        END IF;
+    END IF;
+    IF parts IS NOT NULL THEN
+        FOR month_index IN 1..12 LOOP
+            IF month_names[month_index] LIKE parts[1] || '%' THEN
+                RETURN make_date(parts[2]::INT, month_index, 1);
+            END IF;
+        END LOOP;
+    END IF;
+
+    RETURN NULL;
+EXCEPTION WHEN OTHERS THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Reports whose month cannot be parsed are quarantined here with their original value, and
+-- the migration stops rather than guess a month. The table is filled outside the migration's
+-- transaction so it survives the failure; correct the listed reports and rerun.
+COMMIT;
+
+CREATE TABLE IF NOT EXISTS report_month_of_quarantine (
+    report_id INT PRIMARY KEY REFERENCES reports (id) ON DELETE CASCADE,
+    original_month_of VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+DELETE FROM report_month_of_quarantine q
+USING reports r
+WHERE r.id = q.report_id AND pg_temp.parse_month_of(r.month_of) IS NOT NULL;
+
+INSERT INTO report_month_of_quarantine (report_id, original_month_of, created_at)
+SELECT id, month_of, created_at
+FROM reports
+WHERE pg_temp.parse_month_of(month_of) IS NULL
+ON CONFLICT (report_id) DO UPDATE SET original_month_of = EXCLUDED.original_month_of;
+
+-- Lists the reports that have to be corrected before the migration can run.
+SELECT report_id, original_month_of, created_at
+FROM report_month_of_quarantine
+ORDER BY report_id;
+
+BEGIN;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM report_month_of_quarantine) THEN
+        RAISE EXCEPTION 'reports with an unparseable month_of are listed in report_month_of_quarantine; correct them and rerun';
+    END IF;
+END
+$$;
+
+DROP TABLE report_month_of_quarantine;
+
+ALTER TABLE reports ADD COLUMN period DATE;
+UPDATE reports SET period = pg_temp.parse_month_of(month_of);
+
+ALTER TABLE reports DROP COLUMN month_of;
+ALTER TABLE reports RENAME COLUMN period TO month_of;
+ALTER TABLE reports ALTER COLUMN month_of SET NOT NULL;
+ALTER TABLE reports ADD CONSTRAINT reports_month_of_first_day CHECK (EXTRACT(DAY FROM month_of) = 1);
+
+CREATE INDEX reports_month_of_idx ON reports (month_of);
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- Allows one report per worker, church and month so attendance is not counted twice.
+BEGIN;
+
+-- Lists the duplicates that have to be merged or deleted before the constraint can be added.
+SELECT worker_id, church_id, month_of, array_agg(id ORDER BY updated_at DESC) AS report_ids
+FROM reports
+GROUP BY worker_id, church_id, month_of
+HAVING count(*) > 1
+ORDER BY month_of, worker_id, church_id;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM reports
+        GROUP BY worker_id, church_id, month_of
+        HAVING count(*) > 1
+    ) THEN
+        RAISE EXCEPTION 'duplicate reports exist for the same worker, church and month; resolve the rows listed above and rerun';
+    END IF;
+END
+$$;
+
+ALTER TABLE reports
+    ADD CONSTRAINT reports_worker_church_month_key UNIQUE (worker_id, church_id, month_of);
+
+COMMIT;
@@ -0,0 +1,116 @@
+-- Turns the bare weekly count arrays, e.g. [30, 28, 35], into dated entries,
+-- e.g. [{"date": "2024-03-03", "count": 30}, ...], the way the service reads
+-- undated counts (model.Period.UndatedDates): the n-th count is dated to the
+-- n-th Sunday of the report's month, and counts beyond the last Sunday go on
+-- the latest days of the month not yet used, going backwards, so no two
+-- entries share a date.
+--
+-- The API now accepts at most six entries per activity, but older versions
+-- did not enforce a limit, so longer arrays may be stored. Rather than drop
+-- the extra counts or leave reports that fail validation on their next edit,
+-- such arrays are quarantined here with their original value and the
+-- migration stops. The table is filled outside the migration's transaction
+-- so it survives the failure; shorten the listed arrays and rerun. Six
+-- counts fit even in February, so every remaining count gets a date.
+CREATE TABLE IF NOT EXISTS report_weekly_entries_quarantine (
+    report_id INT NOT NULL REFERENCES reports (id) ON DELETE CASCADE,
+    activity VARCHAR(50) NOT NULL,
+    original_entries JSONB NOT NULL,
+    PRIMARY KEY (report_id, activity)
+);
+
+DELETE FROM report_weekly_entries_quarantine;
+
+INSERT INTO report_weekly_entries_quarantine (report_id, activity, original_entries)
+SELECT r.id, a.activity, a.entries
+FROM reports r, LATERAL (VALUES
+    ('worship_service', r.worship_service),
+    ('sunday_school', r.sunday_school),
+    ('prayer_meetings', r.prayer_meetings),
+    ('bible_studies', r.bible_studies),
+    ('mens_fellowships', r.mens_fellowships),
+    ('womens_fellowships', r.womens_fellowships),
+    ('youth_fellowships', r.youth_fellowships),
+    ('child_fellowships', r.child_fellowships),
+    ('outreach', r.outreach),
+    ('training_or_seminars', r.training_or_seminars),
+    ('leadership_conferences', r.leadership_conferences),
+    ('leadership_training', r.leadership_training),
+    ('others', r.others),
+    ('family_days', r.family_days),
+    ('tithes_and_offerings', r.tithes_and_offerings),
+    ('home_visited', r.home_visited),
+    ('bible_study_or_group_led', r.bible_study_or_group_led),
+    ('sermon_or_message_preached', r.sermon_or_message_preached),
+    ('person_newly_contacted', r.person_newly_contacted),
+    ('person_followed_up', r.person_followed_up),
+    ('person_led_to_christ', r.person_led_to_christ)
+) AS a(activity, entries)
+WHERE jsonb_typeof(a.entries) = 'array' AND jsonb_array_length(a.entries) > 6;
+
+-- Lists the arrays that have to be shortened before the migration can run.
+SELECT report_id, activity, jsonb_array_length(original_entries) AS entries, original_entries
+FROM report_weekly_entries_quarantine
+ORDER BY report_id, activity;
+
+BEGIN;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM report_weekly_entries_quarantine) THEN
+        RAISE EXCEPTION 'reports with more than six weekly entries are listed in report_weekly_entries_quarantine; shorten them and rerun';
+    END IF;
+END
+$$;
+
+DROP TABLE report_weekly_entries_quarantine;
+
+CREATE FUNCTION pg_temp.undated_days(month DATE) RETURNS TABLE (ord BIGINT, day DATE) AS $$
+    SELECT
+        row_number() OVER (
+            ORDER BY EXTRACT(DOW FROM d) <> 0, CASE WHEN EXTRACT(DOW FROM d) = 0 THEN d END, d DESC
+        ),
+        d::DATE
+    FROM generate_series(month, month + INTERVAL '1 month' - INTERVAL '1 day', INTERVAL '1 day') AS d
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE FUNCTION pg_temp.dated_entries(counts JSONB, month DATE) RETURNS JSONB AS $$
+    SELECT CASE
+        WHEN counts IS NULL OR jsonb_typeof(counts) <> 'array' THEN counts
+        WHEN jsonb_array_length(counts) = 0 THEN counts
+        WHEN jsonb_typeof(counts -> 0) = 'object' THEN counts
+        ELSE (
+            SELECT jsonb_agg(
+                jsonb_build_object('date', to_char(undated.day, 'YYYY-MM-DD'), 'count', entry.value)
+                ORDER BY entry.ord
+            )
+            FROM jsonb_array_elements(counts) WITH ORDINALITY AS entry(value, ord)
+            JOIN pg_temp.undated_days(month) AS undated ON undated.ord = entry.ord
+        )
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+UPDATE reports SET
+    worship_service = pg_temp.dated_entries(worship_service, month_of),
+    sunday_school = pg_temp.dated_entries(sunday_school, month_of),
+    prayer_meetings = pg_temp.dated_entries(prayer_meetings, month_of),
+    bible_studies = pg_temp.dated_entries(bible_studies, month_of),
+    mens_fellowships = pg_temp.dated_entries(mens_fellowships, month_of),
+    womens_fellowships = pg_temp.dated_entries(womens_fellowships, month_of),
+    youth_fellowships = pg_temp.dated_entries(youth_fellowships, month_of),
+    child_fellowships = pg_temp.dated_entries(child_fellowships, month_of),
+    outreach = pg_temp.dated_entries(outreach, month_of),
+    training_or_seminars = pg_temp.dated_entries(training_or_seminars, month_of),
+    leadership_conferences = pg_temp.dated_entries(leadership_conferences, month_of),
+    leadership_training = pg_temp.dated_entries(leadership_training, month_of),
+    others = pg_temp.dated_entries(others, month_of),
+    family_days = pg_temp.dated_entries(family_days, month_of),
+    tithes_and_offerings = pg_temp.dated_entries(tithes_and_offerings, month_of),
+    home_visited = pg_temp.dated_entries(home_visited, month_of),
+    bible_study_or_group_led = pg_temp.dated_entries(bible_study_or_group_led, month_of),
+    sermon_or_message_preached = pg_temp.dated_entries(sermon_or_message_preached, month_of),
+    person_newly_contacted = pg_temp.dated_entries(person_newly_contacted, month_of),
+    person_followed_up = pg_temp.dated_entries(person_followed_up, month_of),
+    person_led_to_christ = pg_temp.dated_entries(person_led_to_christ, month_of);
+
+COMMIT;
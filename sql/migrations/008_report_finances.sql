@@ -0,0 +1,46 @@
+-- Replaces the weekly tithes_and_offerings counts with monthly money totals in
+-- minor units (centavos for PHP). The old column did not tell tithes and
+-- offerings apart, so its weekly values are summed into offerings_minor as
+-- whole pesos; tithes_minor and special_gifts_minor start at zero. Weeks
+-- recorded as null (not held) add nothing.
+--
+-- That total is a reclassification, not a known split, so the original weekly
+-- figures are kept in legacy_tithes_and_offerings. A non-null value there flags
+-- a report whose offerings_minor also holds its tithes; the query at the end
+-- lists them for correction.
+BEGIN;
+
+ALTER TABLE reports
+    ADD COLUMN tithes_minor BIGINT NOT NULL DEFAULT 0 CHECK (tithes_minor >= 0),
+    ADD COLUMN offerings_minor BIGINT NOT NULL DEFAULT 0 CHECK (offerings_minor >= 0),
+    ADD COLUMN special_gifts_minor BIGINT NOT NULL DEFAULT 0 CHECK (special_gifts_minor >= 0),
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'PHP';
+
+UPDATE reports r
+SET offerings_minor = legacy.total * 100
+FROM (
+    SELECT reports.id, COALESCE(SUM(
+        CASE jsonb_typeof(entry.value)
+            WHEN 'number' THEN (entry.value #>> '{}')::NUMERIC
+            WHEN 'object' THEN (entry.value ->> 'count')::NUMERIC
+        END
+    ), 0)::BIGINT AS total
+    FROM reports, jsonb_array_elements(reports.tithes_and_offerings) AS entry(value)
+    WHERE jsonb_typeof(reports.tithes_and_offerings) = 'array'
+    GROUP BY reports.id
+) AS legacy
+WHERE r.id = legacy.id AND legacy.total > 0;
+
+ALTER TABLE reports RENAME COLUMN tithes_and_offerings TO legacy_tithes_and_offerings;
+ALTER TABLE reports DROP COLUMN tithes_and_offerings_avg;
+
+COMMENT ON COLUMN reports.legacy_tithes_and_offerings IS
+    'Weekly tithes and offerings from before migration 008. Their sum was moved to offerings_minor; split it into tithes_minor by hand.';
+
+-- Lists the reports whose offerings include reclassified tithes.
+SELECT id, month_of, offerings_minor, legacy_tithes_and_offerings
+FROM reports
+WHERE legacy_tithes_and_offerings IS NOT NULL AND offerings_minor > 0
+ORDER BY month_of, id;
+
+COMMIT;
@@ -0,0 +1,28 @@
+-- Adds the submission workflow: every report has a status, and every change of
+-- status is recorded with the user who made it. Reports filed before the
+-- workflow existed were already in use, so they start out submitted and wait
+-- for review like any newly submitted report.
+BEGIN;
+
+ALTER TABLE reports
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'submitted', 'under_review', 'returned', 'approved'));
+
+UPDATE reports SET status = 'submitted';
+
+CREATE INDEX reports_status_idx ON reports (status);
+
+CREATE TABLE report_status_changes (
+    id SERIAL PRIMARY KEY,
+    report_id INT NOT NULL REFERENCES reports (id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor_id INT REFERENCES users (id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX report_status_changes_report_id_idx ON report_status_changes (report_id);
+
+COMMIT;
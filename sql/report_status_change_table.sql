@@ -0,0 +1,12 @@
+CREATE TABLE report_status_changes (
+    id SERIAL PRIMARY KEY,
+    report_id INT NOT NULL REFERENCES reports (id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor_id INT REFERENCES users (id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX report_status_changes_report_id_idx ON report_status_changes (report_id);
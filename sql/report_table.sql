@@ -1,9 +1,12 @@
 CREATE TABLE reports (
     id SERIAL PRIMARY KEY,
-    month_of VARCHAR(100) NOT NULL,
-    worker_name VARCHAR(100) NOT NULL,
-    area_of_assignment VARCHAR(100) NOT NULL,
-    name_of_church VARCHAR(100) NOT NULL,
+    user_id INT REFERENCES users (id) ON DELETE SET NULL,
+    month_of DATE NOT NULL CHECK (EXTRACT(DAY FROM month_of) = 1),
+    worker_id INT NOT NULL REFERENCES workers (id),
+    area_id INT NOT NULL REFERENCES areas (id),
+    church_id INT NOT NULL REFERENCES churches (id),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'submitted', 'under_review', 'returned', 'approved')),
     worship_service JSONB NOT NULL,
     sunday_school JSONB NOT NULL,
     prayer_meetings JSONB,
@@ -18,8 +21,11 @@ CREATE TABLE reports (
     leadership_training JSONB,
     others JSONB,
     family_days JSONB,
-    tithes_and_offerings JSONB,
     average_attendance FLOAT8 NOT NULL,
+    tithes_minor BIGINT NOT NULL DEFAULT 0 CHECK (tithes_minor >= 0),
+    offerings_minor BIGINT NOT NULL DEFAULT 0 CHECK (offerings_minor >= 0),
+    special_gifts_minor BIGINT NOT NULL DEFAULT 0 CHECK (special_gifts_minor >= 0),
+    currency CHAR(3) NOT NULL DEFAULT 'PHP',
     worship_service_avg FLOAT8 DEFAULT NULL,
     sunday_school_avg FLOAT8 DEFAULT NULL,
     prayer_meetings_avg FLOAT8 DEFAULT NULL,
@@ -34,7 +40,6 @@ CREATE TABLE reports (
     leadership_training_avg FLOAT8 DEFAULT NULL,
     others_avg FLOAT8 DEFAULT NULL,
     family_days_avg FLOAT8 DEFAULT NULL,
-    tithes_and_offerings_avg FLOAT8 DEFAULT NULL,
     home_visited JSONB,
     bible_study_or_group_led JSONB,
     sermon_or_message_preached JSONB,
@@ -52,5 +57,13 @@ CREATE TABLE reports (
     challenges_and_problem_encountered TEXT,
     prayer_request TEXT,
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT reports_worker_church_month_key UNIQUE (worker_id, church_id, month_of)
 );
+
+CREATE INDEX reports_user_id_idx ON reports (user_id);
+CREATE INDEX reports_area_id_idx ON reports (area_id);
+CREATE INDEX reports_worker_id_idx ON reports (worker_id);
+CREATE INDEX reports_church_id_idx ON reports (church_id);
+CREATE INDEX reports_month_of_idx ON reports (month_of);
+CREATE INDEX reports_status_idx ON reports (status);
@@ -0,0 +1,22 @@
+-- Bootstraps the first administrator so they can log in and register everyone else.
+-- There is no default password: pass one to psql, and the seed stops if it is
+-- missing or shorter than the 8 characters the API requires.
+--
+--   psql -v ON_ERROR_STOP=1 -v admin_password="$ADMIN_PASSWORD" -f sql/user_seed.sql
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+SELECT set_config('seed.admin_password', :'admin_password', false);
+
+DO $$
+BEGIN
+    IF length(current_setting('seed.admin_password')) < 8 THEN
+        RAISE EXCEPTION 'admin_password must be at least 8 characters';
+    END IF;
+
+    INSERT INTO users (username, name, password, role)
+    VALUES ('admin', 'Administrator', crypt(current_setting('seed.admin_password'), gen_salt('bf', 10)), 'admin')
+    ON CONFLICT (username) DO NOTHING;
+END
+$$;
+
+SELECT set_config('seed.admin_password', '', false);